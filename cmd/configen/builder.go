@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// this file contains the template pipes for the WithBuilder and WithSetter options,
+// which generate a fluent builder, e.g. NewHTTPServerBuilder().BindAddr(":8080").Build()
+// and Set<Field> methods for the struct.
+
+// setterParam returns the type of the parameter of the builder and setter methods
+// for the field, and the expression that converts the parameter v to the field type,
+// these match the types returned by the getters
+func (f *fieldInfo) setterParam() (string, string) {
+	switch {
+	case f.IsBoolPtr():
+		return "bool", "&v"
+	case f.IsDuration():
+		return "time.Duration", "Duration(v)"
+	}
+	return f.GoType.Name, "v"
+}
+
+// SetterArg pipe returns the expression that gets the value of the field from
+// the struct in the variable c, as the parameter of the builder or setter method
+func (f *fieldInfo) SetterArg(c string) string {
+	switch {
+	case f.IsBoolPtr():
+		return fmt.Sprintf("*%s.%s", c, f.Name)
+	case f.IsDuration():
+		return fmt.Sprintf("%s.%s.TimeDuration()", c, f.Name)
+	}
+	return fmt.Sprintf("%s.%s", c, f.Name)
+}
+
+// DefaultsJSON returns the json object with the default values from the definition
+// for the fields of the struct, and of its nested structs, or an empty string if
+// there aren't any
+func (s *structInfo) DefaultsJSON() string {
+	d := s.defaults()
+	if len(d) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func (s *structInfo) defaults() map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, f := range s.Fields {
+		if f.embedded {
+			// the defaults of the embedded type are promoted, the fields of the struct are added after them
+			for k, v := range f.GoType.structDef.defaults() {
+				res[k] = v
+			}
+			continue
+		}
+		if len(f.Default) > 0 && json.Valid(f.Default) {
+			res[f.Key()] = f.Default
+		} else if f.IsStruct() {
+			if d := f.GoType.structDef.defaults(); len(d) > 0 {
+				res[f.Key()] = d
+			}
+		}
+	}
+	return res
+}
+
+// BuilderImpl pipe returns the <Name>Builder type, its constructor, a method to
+// set each field, and the Build method
+func (s *structInfo) BuilderImpl() string {
+	n := s.GoType.Name
+	bn := n + "Builder"
+	list := []string{fmt.Sprintf(`// %[1]s builds a %[2]s, it starts with the default values from the definition,
+// and the Build method returns the %[2]s
+type %[1]s struct {
+	c   %[2]s
+	err error
+}`, bn, n)}
+
+	if d := s.DefaultsJSON(); d != "" {
+		lit := strconv.Quote(d)
+		if !strings.Contains(d, "`") {
+			lit = "`" + d + "`"
+		}
+		list = append(list, fmt.Sprintf(`// New%[1]s returns a new %[1]s, with the default values from the definition
+func New%[1]s() *%[1]s {
+	b := &%[1]s{}
+	if err := json.Unmarshal([]byte(%[2]s), &b.c); err != nil {
+		b.err = fmt.Errorf("unable to apply the defaults of %[3]s: %%v", err)
+	}
+	return b
+}`, bn, lit, n))
+	} else {
+		list = append(list, fmt.Sprintf(`// New%[1]s returns a new %[1]s
+func New%[1]s() *%[1]s {
+	return &%[1]s{}
+}`, bn))
+	}
+
+	for _, f := range s.Fields {
+		pt, v := f.setterParam()
+		list = append(list, fmt.Sprintf(`// %[1]s sets the %[1]s field
+func (b *%[2]s) %[1]s(v %[3]s) *%[2]s {
+	b.c.%[1]s = %[4]s
+	return b
+}`, f.Name, bn, pt, v))
+	}
+
+	list = append(list, fmt.Sprintf(`// Build returns a copy of the %[1]s that has been built, if the %[1]s
+// has a Validate method, it's called and any error is returned
+func (b *%[2]s) Build() (*%[1]s, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	c := b.c.Clone()
+	if v, ok := interface{}(c).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}`, n, bn))
+	return strings.Join(list, "\n\n")
+}
+
+// SettersImpl pipe returns the <Name>Setter interface, and the Set<Field>
+// methods that implement it
+func (s *structInfo) SettersImpl() string {
+	n := s.GoType.Name
+	sn := n + "Setter"
+	decls := make([]string, 0, len(s.Fields))
+	methods := make([]string, 0, len(s.Fields))
+	for _, f := range s.Fields {
+		if f.embedded {
+			// the setters of the embedded type are promoted
+			decls = append(decls, "\t"+f.GoType.Name+"Setter")
+			continue
+		}
+		pt, v := f.setterParam()
+		decls = append(decls, fmt.Sprintf("\t// Set%[1]s sets the %[1]s field\n\tSet%[1]s(v %[2]s)", f.Name, pt))
+		methods = append(methods, fmt.Sprintf(`// Set%[1]s sets the %[1]s field
+func (c *%[2]s) Set%[1]s(v %[3]s) {
+	c.%[1]s = %[4]s
+}`, f.Name, n, pt, v))
+	}
+	return fmt.Sprintf("// %s allows the fields of a %s to be changed in place\ntype %s interface {\n%s\n}\n\n%s",
+		sn, n, sn, strings.Join(decls, "\n"), strings.Join(methods, "\n\n"))
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DefaultsJSON(t *testing.T) {
+	def, err := loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"Timeout":"30s"}`, def.Structs["HTTPClient"].DefaultsJSON())
+	assert.Equal(t, `{"MaxAgeDays":7}`, def.Structs["Logger"].DefaultsJSON())
+	assert.Equal(t, `{"Audit":{"MaxAgeDays":7},"Client":{"Timeout":"30s"}}`, def.Structs["Configuration"].DefaultsJSON())
+	assert.Equal(t, "", def.Structs["TLSInfo"].DefaultsJSON())
+}
+
+func Test_BuilderImpl(t *testing.T) {
+	def, err := loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	impl := def.Structs["HTTPClient"].BuilderImpl()
+	assert.Contains(t, impl, "if err := json.Unmarshal([]byte(`{\"Timeout\":\"30s\"}`), &b.c); err != nil {")
+	assert.Contains(t, impl, "func (b *HTTPClientBuilder) Timeout(v time.Duration) *HTTPClientBuilder {\n\tb.c.Timeout = Duration(v)\n")
+	assert.Contains(t, impl, "func (b *HTTPClientBuilder) Build() (*HTTPClient, error) {")
+
+	impl = def.Structs["TLSInfo"].BuilderImpl()
+	assert.Contains(t, impl, "func NewTLSInfoBuilder() *TLSInfoBuilder {\n\treturn &TLSInfoBuilder{}\n}")
+
+	setters := def.Structs["TLSInfo"].SettersImpl()
+	assert.Contains(t, setters, "type TLSInfoSetter interface {\n")
+	assert.Contains(t, setters, "func (c *TLSInfo) SetClientCertAuth(v bool) {\n\tc.ClientCertAuth = &v\n}")
+	assert.Equal(t, "*exp.ClientCertAuth", def.Structs["TLSInfo"].Fields[3].SetterArg("exp"))
+}
+
+func Test_FieldNameClash(t *testing.T) {
+	s := &structInfo{WithBuilder: true, Fields: []fieldInfo{{Name: "Build", Type: "string"}}}
+	errs := s.validateFieldNames("Job")
+	require.Len(t, errs, 1)
+	assert.Equal(t, ".Fields[0].name: field Build of Job clashes with the Build method of its builder, it can't be WithBuilder", errs.Error())
+
+	s = &structInfo{WithSetter: true, Fields: []fieldInfo{{Name: "Path", Type: "string"}}}
+	errs = s.validateFieldNames("File")
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs.Error(), "field Path of File clashes with the generated SetPath method")
+
+	s.WithSetter = false
+	assert.Empty(t, s.validateFieldNames("File"))
+	s.WithGetter = true
+	errs = s.validateFieldNames("File")
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs.Error(), "field Path of File clashes with the generated GetPath method, it can't be WithGetter")
+
+	s = &structInfo{Fields: []fieldInfo{{Name: "Clone", Type: "string"}, {Name: "Equal", Type: "string"}, {Name: "Diff", Type: "string"}, {Name: "Walk", Type: "string"}}}
+	errs = s.validateFieldNames("File")
+	require.Len(t, errs, 4)
+	assert.Equal(t, ".Fields[0].name: field Clone of File has the same name as the generated Clone method", errs[0].Error())
+}
+
+func Test_FieldNameClashDefinition(t *testing.T) {
+	f, err := ioutil.TempFile("", "clash")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString(`{
+    "PackageName" : "clash",
+    "Configuration" : {
+        "WithGetter" : true,
+        "Fields" : [
+            { "name" : "Path",  "type" : "string", "comment" : "Path of the files" },
+            { "name" : "Equal", "type" : "string", "comment" : "Equal is a generated method" }
+        ]
+    }
+}`)
+	f.Close()
+	_, err = loadConfig(f.Name())
+	require.Error(t, err)
+	errs, ok := errors.Cause(err).(definitionErrors)
+	require.True(t, ok, "%T", errors.Cause(err))
+	require.Len(t, errs, 2)
+	assert.Equal(t, f.Name()+":6:24: Configuration.Fields[0].name: field Path of Configuration clashes with the generated GetPath method, it can't be WithGetter", errs[0].Error())
+	assert.Equal(t, f.Name()+":7:24: Configuration.Fields[1].name: field Equal of Configuration has the same name as the generated Equal method", errs[1].Error())
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// this file contains the generation of the config inspection command, this is
+// a main package in cmd/<pkg>-config [relative to the module root] that uses
+// the generated package to show, explain, validate & diff configuration files
+
+// cliData is the data used to execute the cli template
+type cliData struct {
+	*templateData
+	// ImportPath is the import path of the generated config package
+	ImportPath string
+}
+
+// generateCLI will load the config definition file, and write the inspection
+// command for the package generated in destDir. importPath is the import path of
+// the generated package, if empty its derived from the go.mod file.
+func generateCLI(defFile, destDir, importPath string) (string, error) {
+	def, err := loadConfig(defFile)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return def.writeCLI(destDir, importPath)
+}
+
+// writeCLI writes the main.go of the inspection command, and returns its filename.
+// the command is written to cmd/<pkg>-config in the module root that contains
+// destDir [or in destDir if the importPath is specified and there's no go.mod]
+func (def *templateData) writeCLI(destDir, importPath string) (string, error) {
+	if err := def.setDefaultPackageName(destDir); err != nil {
+		return "", errors.Trace(err)
+	}
+	absDest, err := filepath.Abs(destDir)
+	if err != nil {
+		return "", errors.Errorf("unable to resolve destination directory: %v", err)
+	}
+	root, modulePath, err := findModule(absDest)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if root == "" {
+		if importPath == "" {
+			return "", errors.Errorf("unable to find the go.mod file for %s, specify the import path of the generated package with -import", destDir)
+		}
+		root = absDest
+	}
+	if importPath == "" {
+		rel, err := filepath.Rel(root, absDest)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		importPath = path.Join(modulePath, filepath.ToSlash(rel))
+	}
+
+	t, err := loadTemplates()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	cliDir := filepath.Join(root, "cmd", def.PackageName+"-config")
+	if err = os.MkdirAll(cliDir, 0775); err != nil {
+		return "", errors.Errorf("unable to create cli directory: %v", err)
+	}
+	fn := filepath.Join(cliDir, "main.go")
+	f, err := os.Create(fn)
+	if err != nil {
+		return "", errors.Errorf("unable to create destination file: %v", err)
+	}
+	defer f.Close()
+	if err = t.ExecuteTemplate(f, "/cli.go.template", &cliData{templateData: def, ImportPath: importPath}); err != nil {
+		return "", errors.Errorf("unable to generate code: %v", err)
+	}
+	gofmt(fn)
+	return fn, nil
+}
+
+// findModule looks for the go.mod file in dir and its parents, and returns the
+// directory that contains it and the module path, or empty strings if not found
+func findModule(dir string) (string, string, error) {
+	for {
+		f, err := os.Open(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			defer f.Close()
+			s := bufio.NewScanner(f)
+			for s.Scan() {
+				l := strings.TrimSpace(s.Text())
+				if strings.HasPrefix(l, "module") {
+					mp := strings.TrimSpace(strings.TrimPrefix(l, "module"))
+					if i := strings.Index(mp, "//"); i >= 0 {
+						mp = strings.TrimSpace(mp[:i])
+					}
+					return dir, strings.Trim(mp, `"`), nil
+				}
+			}
+			return "", "", errors.Errorf("unable to find the module path in %s", filepath.Join(dir, "go.mod"))
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", nil
+		}
+		dir = parent
+	}
+}
+
+// HasSecrets returns true if any of the fields of the Configuration are secret,
+// including those of its nested structs
+func (td *templateData) HasSecrets() bool {
+	return td.Structs["Configuration"].ContainsSecrets()
+}
+
+// ContainsSecrets returns true if any of the fields of the struct are secret,
+// including those of its nested structs
+func (s *structInfo) ContainsSecrets() bool {
+	return s.containsSecrets(map[*structInfo]bool{})
+}
+
+// containsSecrets returns true if the struct has secret fields, the structs that
+// are in visited have already been checked
+func (s *structInfo) containsSecrets(visited map[*structInfo]bool) bool {
+	visited[s] = true
+	for _, f := range s.GetterFields() {
+		if nested := f.GoType.structDef; f.Secret || (nested != nil && !visited[nested] && nested.containsSecrets(visited)) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskImpl pipe returns the functions of the inspection command that mask the values
+// of the secret fields of the struct, the same as the generated Diff method does,
+// mask<Type> masks them in the decoded json of the struct, and secret<Type> returns
+// true if the path of a value in the struct is a secret field, or is inside one
+func (s *structInfo) MaskImpl() string {
+	n := s.GoType.Name
+	var masks, cases []string
+	rest := "_"
+	for idx := range s.Fields {
+		f := &s.Fields[idx]
+		nested := f.GoType.structDef
+		switch {
+		case f.embedded && !nested.ContainsSecrets():
+		case f.embedded:
+			// the fields of the embedded type are promoted
+			masks = append(masks, fmt.Sprintf("mask%s(v)", nested.GoType.Name))
+			if names := nested.promotedNames(); len(names) > 0 {
+				quoted := make([]string, len(names))
+				for i, pn := range names {
+					quoted[i] = fmt.Sprintf("%q", pn)
+				}
+				cases = append(cases, fmt.Sprintf("case %s:\n\treturn secret%s(path)", strings.Join(quoted, ", "), nested.GoType.Name))
+			}
+		case f.Secret:
+			masks = append(masks, fmt.Sprintf("if _, exists := m[%[1]q]; exists {\n\tm[%[1]q] = secretMask\n}", f.Key()))
+			cases = append(cases, fmt.Sprintf("case %q:\n\treturn true", f.Name))
+		case nested != nil && nested.ContainsSecrets() && f.GoType.IsMap():
+			// the path continues with the key of the value
+			masks = append(masks, fmt.Sprintf("if mv, ok := m[%q].(map[string]interface{}); ok {\n\tfor _, e := range mv {\n\t\tmask%s(e)\n\t}\n}", f.Key(), nested.GoType.Name))
+			cases = append(cases, fmt.Sprintf("case %q:\n\t_, rest = splitPath(rest)\n\treturn secret%s(rest)", f.Name, nested.GoType.Name))
+			rest = "rest"
+		case nested != nil && nested.ContainsSecrets():
+			masks = append(masks, fmt.Sprintf("maskEach(m[%q], mask%s)", f.Key(), nested.GoType.Name))
+			cases = append(cases, fmt.Sprintf("case %q:\n\treturn secret%s(rest)", f.Name, nested.GoType.Name))
+			rest = "rest"
+		}
+	}
+	// the struct contains secrets, so there's at least one case
+	secret := fmt.Sprintf("name, %s := splitPath(path)\n\tswitch name {\n\t%s\n\t}\n\treturn false", rest, strings.Join(cases, "\n"))
+	return fmt.Sprintf(`// mask%[1]s masks the values of the secret fields in v, the decoded json of a %[1]s
+func mask%[1]s(v interface{}) {
+	m, _ := v.(map[string]interface{})
+	if m == nil {
+		return
+	}
+	%[2]s
+}
+
+// secret%[1]s returns true if the path of a value in a %[1]s is a secret field, or is inside one
+func secret%[1]s(path string) bool {
+	%[3]s
+}`, n, strings.Join(masks, "\n"), secret)
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindModule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "findmodule")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	nested := filepath.Join(dir, "internal", "config")
+	require.NoError(t, os.MkdirAll(nested, 0775))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module \"example.com/svc\" // the service\n\ngo 1.14\n"), 0664))
+
+	root, mp, err := findModule(nested)
+	require.NoError(t, err)
+	assert.Equal(t, dir, root)
+	assert.Equal(t, "example.com/svc", mp)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("go 1.14\n"), 0664))
+	_, _, err = findModule(nested)
+	assert.Error(t, err)
+}
+
+func Test_GenerateCLI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping end to end test in short mode")
+	}
+	dir, err := ioutil.TempDir("", "cli")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/svc\n\ngo 1.16\n"), 0664))
+	destDir := filepath.Join(dir, "internal", "testgen")
+	require.NoError(t, os.MkdirAll(destDir, 0775))
+
+	def, err := loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	require.NoError(t, def.generate(destDir))
+	require.NoError(t, def.writeSample(destDir))
+	fn, err := def.writeCLI(destDir, "")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "cmd", "testgen-config", "main.go"), fn)
+
+	src, err := ioutil.ReadFile(fn)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), `testgen "example.com/svc/internal/testgen"`)
+
+	bin := filepath.Join(dir, "testgen-config")
+	goCmd(t, dir, "build", "-o", bin, "./cmd/testgen-config")
+	goCmd(t, dir, "vet", "./cmd/...")
+	goLint(t, "testgen-config", filepath.Join(dir, "cmd", "testgen-config"))
+
+	sample := filepath.Join(destDir, "config.sample.json")
+	run := func(args ...string) string {
+		out, err := exec.Command(bin, append([]string{"-c", sample}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+		return string(out)
+	}
+	assert.Equal(t, "host1.example.com -> example\n", run("hosts"))
+	assert.Equal(t, "ok, 1 hosts\n", run("validate"))
+	assert.Equal(t, "HTTP.ServiceName: service-name -> service-name-2\n", run("diff", "-host", "other", "-host", "host1.example.com"))
+
+	out := run("show", "-host", "host1.example.com", "-o", "yaml")
+	assert.Contains(t, out, "\nHTTP:\n  AllowProfiling: false\n")
+	assert.Contains(t, out, "\n  ServiceName: \"service-name-2\"\n")
+	out = run("show", "-host", "host1.example.com")
+	assert.True(t, strings.HasPrefix(out, "{\n"), out)
+	assert.Contains(t, out, "\"ClusterToken\": \"****\"", "the secret fields should be masked")
+	assert.NotContains(t, out, "cluster-token")
+	out = run("show", "-host", "host1.example.com", "-o", "yaml")
+	assert.Contains(t, out, "\n  ClusterToken: \"****\"\n")
+
+	out = run("explain", "-host", "host1.example.com")
+	assert.Contains(t, out, "hostname: host1.example.com\noverride: example\n")
+	assert.Contains(t, out, "\nHTTP.ServiceName = \"service-name-2\" (override example)\n")
+	assert.Contains(t, out, "\nClient.Timeout = \"30s\" (default)\n")
+	assert.Contains(t, out, "\nEtcd.ClusterToken = \"****\" (default)\n")
+
+	// add a selector for the example override
+	sb, err := ioutil.ReadFile(sample)
+	require.NoError(t, err)
+	var withSelectors map[string]interface{}
+	require.NoError(t, json.Unmarshal(sb, &withSelectors))
+	withSelectors["Selectors"] = []interface{}{
+		map[string]interface{}{"Match": map[string]string{"env": "prod"}, "Override": "example"},
+		map[string]interface{}{"Match": map[string]string{"env": "dev"}, "Override": "missing"},
+	}
+	sb, err = json.Marshal(withSelectors)
+	require.NoError(t, err)
+	sample = filepath.Join(dir, "selectors.json")
+	require.NoError(t, ioutil.WriteFile(sample, sb, 0664))
+
+	out = run("-label", "env=prod", "explain", "-host", "other")
+	assert.Contains(t, out, "hostname: other\nselector: env=prod -> example\n")
+	assert.Contains(t, out, "\nHTTP.ServiceName = \"service-name-2\" (override example)\n")
+	assert.Equal(t, "no differences between other and host1.example.com\n", run("-label", "env=prod", "diff", "-host", "other", "-host", "host1.example.com"))
+
+	assert.Equal(t, "ok, 1 hosts\n", run("-label", "env=prod", "validate"))
+	b, err := exec.Command(bin, "-c", sample, "-label", "env=dev", "validate").CombinedOutput()
+	assert.Error(t, err, "validate should use the selectors that match the labels")
+	assert.Contains(t, string(b), "host host1.example.com: Configuration selector 1 specified override set missing but that doesn't exist")
+
+	b, err = exec.Command(bin, "-c", sample, "bogus").CombinedOutput()
+	assert.Error(t, err)
+	assert.Contains(t, string(b), `unknown command "bogus"`)
+}
+
+func Test_WriteCLINoModule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clinomod")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	def, err := loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	if root, _, _ := findModule(dir); root != "" {
+		t.Skipf("temp dir %s is inside a module", dir)
+	}
+	_, err = def.writeCLI(dir, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "specify the import path of the generated package with -import")
+
+	fn, err := def.writeCLI(dir, "example.com/other/testgen")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "cmd", "testgen-config", "main.go"), fn)
+}
+
+func goCmd(t *testing.T, dir string, args ...string) {
+	c := exec.Command("go", args...)
+	c.Dir = dir
+	res, err := c.CombinedOutput()
+	require.NoError(t, err, "go %s: %s", strings.Join(args, " "), string(res))
+}
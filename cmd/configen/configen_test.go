@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/juju/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -92,18 +93,33 @@ func Test_defaultPacakgeName(t *testing.T) {
 func Test_InvalidType(t *testing.T) {
 	err := generateConfig("testdata/invalid_type_test.json", ".")
 	assert.Error(t, err)
-	assert.True(t, strings.HasPrefix(err.Error(), "field bob has type Alice which isn't valid"))
+	assert.True(t, strings.HasPrefix(err.Error(), "testdata/invalid_type_test.json:5:34: Configuration.Fields[0].type: field bob of Configuration has type Alice which isn't valid (valid types are *bool,"), err.Error())
 
 	err = generateConfig("testdata/invalid_type_rel_test.json", ".")
 	assert.Error(t, err)
-	assert.True(t, strings.HasPrefix(err.Error(), "field bob has type Alice which isn't valid"))
+	assert.True(t, strings.HasPrefix(err.Error(), "testdata/invalid_type_rel_test.json:11:42: RelatedTypes.Bob.Fields[0].type: field bob of Bob has type Alice which isn't valid"), err.Error())
+
+	// all the errors are reported, in the order they are in the file
+	_, err = loadConfig("testdata/lint_test.json")
+	require.Error(t, err)
+	errs, ok := errors.Cause(err).(definitionErrors)
+	require.True(t, ok, "%T", errors.Cause(err))
+	require.Len(t, errs, 5)
+	assert.Equal(t, "testdata/lint_test.json:6:37: Configuration.Fields[0].type: field HTTP of Configuration has type HTTPServr which isn't valid (did you mean HTTPServer?)", errs[0].Error())
+	assert.Equal(t, "Configuration", errs[0].Struct)
+	assert.Contains(t, errs[1].Error(), ":7:37: Configuration.Fields[1].type: field bob of Configuration has type Alice which isn't valid (valid types are")
+	assert.Contains(t, errs[2].Error(), ":8:37: Configuration.Fields[2].type: field Expiry of Configuration has type duration which isn't valid (did you mean Duration?)")
+	assert.Equal(t, "testdata/lint_test.json:18:20: RelatedTypes.HTTPServer.Fields[2].name: field Clone of HTTPServer has the same name as the generated Clone method", errs[3].Error())
+	assert.Equal(t, "testdata/lint_test.json:20:39: RelatedTypes.HTTPServer.Fields[4].type: field Listen of HTTPServer has type []strng which isn't valid (did you mean []string?)", errs[4].Error())
+	assert.Equal(t, "HTTPServer", errs[4].Struct)
+	assert.Equal(t, "RelatedTypes.HTTPServer.Fields[4].type", errs[4].Path)
 }
 
 func Test_InvalidJson(t *testing.T) {
 	err := generateConfig("testdata/invalid_json_test.json", ".")
 	assert.Error(t, err)
 	assert.True(t,
-		strings.HasPrefix(err.Error(), "unable to parse configuration definition file testdata/invalid_json_test.json: invalid character 'b' looking for beginning of object"),
+		strings.HasPrefix(err.Error(), "testdata/invalid_json_test.json:1:3: unable to parse configuration definition file: invalid character 'b' looking for beginning of object"),
 		"got: "+err.Error())
 
 	err = generateConfig("testdata/missing.json", ".")
@@ -141,6 +157,11 @@ func genCompileTest(t *testing.T, tc os.FileInfo) {
 	}()
 	err = generateConfig("testdata/"+tc.Name(), destDir)
 	require.NoError(t, err)
+	def, err := loadConfig("testdata/" + tc.Name())
+	require.NoError(t, err)
+	if def.HasGetters() {
+		require.NoError(t, def.writeMocks(destDir))
+	}
 
 	goBuild(t, pkgName, destDir)
 	goFmt(t, pkgName, destDir)
@@ -221,3 +242,34 @@ func findTestConfigs(t *testing.T) []os.FileInfo {
 	require.True(t, len(res) > 0)
 	return res
 }
+
+func Test_InterfaceGetters(t *testing.T) {
+	def, err := loadConfig("testdata/gen_interface_getters.json")
+	require.NoError(t, err)
+	exp := map[string][2]string{
+		"Name":   {"GetName", "string"},
+		"HTTP":   {"GetHTTPCfg", "HTTPServerConfig"},
+		"Peers":  {"GetPeers", "[]PeerConfig"},
+		"Limits": {"GetLimitsCfg", "*Limits"},
+	}
+	c := def.Structs["Configuration"]
+	for _, f := range c.Fields {
+		mn, rt := f.getter()
+		assert.Equal(t, exp[f.Name], [2]string{mn, rt}, "field %s", f.Name)
+		assert.Equal(t, f.Name == "Peers", f.IsInterfaceSlice(), "field %s", f.Name)
+	}
+	impl := c.GettersImpl()
+	assert.Contains(t, impl, "func (c *Configuration) GetHTTPCfg() HTTPServerConfig {\n\treturn &c.HTTP\n}")
+	assert.Contains(t, impl, "res := make([]PeerConfig, len(c.Peers))")
+	assert.Contains(t, c.MockImpl(), "\t// HTTPCfg is returned by GetHTTPCfg\n\tHTTPCfg HTTPServerConfig\n")
+
+	// without the option the getters return the related types
+	def, err = loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	for _, f := range def.Structs["Configuration"].Fields {
+		if f.Name == "HTTP" {
+			_, rt := f.getter()
+			assert.Equal(t, "*HTTPServer", rt)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// this file contains the check for related types that contain themselves by
+// value, these can't be generated [the go struct would have an infinite size],
+// recursive types have to use a slice, map or pointer of the type instead, e.g.
+// Children []Node or Next *Node
+
+// valueCycles returns an error for each cycle of related types that contain
+// each other by value, the error names the fields that form the cycle
+func valueCycles(structs map[string]*structInfo) definitionErrors {
+	names := make([]string, 0, len(structs))
+	for n := range structs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[*structInfo]int, len(structs))
+	nameOf := make(map[*structInfo]string, len(structs))
+	for _, n := range names {
+		nameOf[structs[n]] = n
+	}
+	// path is the fields that have been followed to get to the current struct
+	type step struct {
+		s   *structInfo
+		idx int
+	}
+	var path []step
+	var errs definitionErrors
+	var visit func(s *structInfo)
+	visit = func(s *structInfo) {
+		state[s] = visiting
+		for idx, f := range s.Fields {
+			if f.GoType == nil || !f.IsStruct() {
+				// the fields with invalid types have already been reported
+				continue
+			}
+			next := f.GoType.structDef
+			path = append(path, step{s, idx})
+			switch state[next] {
+			case visiting:
+				// the cycle is the part of the path that starts at next
+				start := 0
+				for path[start].s != next {
+					start++
+				}
+				cycle := make([]string, 0, len(path)-start+1)
+				for _, st := range path[start:] {
+					cycle = append(cycle, nameOf[st.s]+"."+st.s.Fields[st.idx].Name)
+				}
+				cycle = append(cycle, nameOf[next])
+				first := path[start]
+				errs = append(errs, first.s.errorf(nameOf[first.s], first.s.fieldPath(first.idx, "type"),
+					"related type %s contains itself: %s, use a slice, map or pointer [e.g. []%s or *%s] for a recursive type",
+					nameOf[next], strings.Join(cycle, " -> "), nameOf[next], nameOf[next]))
+			case unvisited:
+				visit(next)
+			}
+			path = path[:len(path)-1]
+		}
+		state[s] = done
+	}
+	for _, n := range names {
+		if state[structs[n]] == unvisited {
+			visit(structs[n])
+		}
+	}
+	return errs
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValueCycles(t *testing.T) {
+	_, err := loadConfig("testdata/invalid_cycle_test.json")
+	require.Error(t, err)
+	errs, ok := errors.Cause(err).(definitionErrors)
+	require.True(t, ok, "%T", errors.Cause(err))
+	// the cycles are reported with the other errors
+	require.Len(t, errs, 3)
+	assert.Equal(t, "testdata/invalid_cycle_test.json:7:37: Configuration.Fields[2].type: field Last of Configuration has type Nod which isn't valid (did you mean Node?)", errs[0].Error())
+	assert.Equal(t, "testdata/invalid_cycle_test.json:19:39: RelatedTypes.Child.Fields[0].type: related type Child contains itself: Child.Parent -> Parent.Child -> Child, use a slice, map or pointer [e.g. []Child or *Child] for a recursive type", errs[1].Error())
+	assert.Equal(t, "testdata/invalid_cycle_test.json:24:37: RelatedTypes.Node.Fields[0].type: related type Node contains itself: Node.Next -> Node, use a slice, map or pointer [e.g. []Node or *Node] for a recursive type", errs[2].Error())
+}
+
+func Test_RecursiveExamples(t *testing.T) {
+	def, err := loadConfig("testdata/gen_recursive.json")
+	require.NoError(t, err)
+
+	route := def.Structs["Route"].GoType
+	require.Len(t, route.ExampleValues, 6)
+	// the Children, Variants and Fallback of the nested Routes are left empty
+	assert.Equal(t, 4, strings.Count(route.ExampleValues[0], "Prefix:"))
+	assert.Equal(t, 1, strings.Count(route.ExampleValues[0], "Children:"))
+	assert.Contains(t, route.ExampleValues[0], "Variants: map[string]Route{\n\"example\": {")
+	assert.Contains(t, route.ExampleValues[0], "Fallback: &Route{")
+	assert.Equal(t, 1, strings.Count(route.ExampleValues[0], "Fallback:"))
+
+	// mutually recursive types are nested maxExampleDepth times
+	team := def.Structs["Team"].GoType
+	assert.Equal(t, maxExampleDepth, strings.Count(team.ExampleValues[0], "Members:"))
+	// the Member has Leads, and so does its Mentor
+	assert.Equal(t, 2, strings.Count(team.ExampleValues[0], "Leads:"))
+	assert.Equal(t, 1, strings.Count(team.ExampleValues[0], "Mentor:"))
+	assert.Len(t, def.Structs["Member"].GoType.ExampleValues, 6)
+	for _, s := range def.Structs {
+		assert.Len(t, s.GoType.ExampleValues, 6, s.GoType.Name)
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// this file contains the support for deprecated and renamed fields, a field with
+// "deprecated" : "use X instead" has Deprecated comments on the field and its getter,
+// and the previous keys of a renamed field can be listed in its "aliases", these are
+// replaced with the current key by the loaders before the config files are decoded.
+// The loaders return a warning for each deprecated field or alias that's used in
+// the config files.
+
+// applyDeprecations validates the aliases of the fields of all the structs, and
+// records which structs can contain deprecated fields or aliases in their json
+func applyDeprecations(structs map[string]*structInfo) definitionErrors {
+	var errs definitionErrors
+	for tn, s := range structs {
+		errs = append(errs, s.validateAliases(tn)...)
+		for idx := range s.Fields {
+			if f := &s.Fields[idx]; f.Deprecated != "" {
+				f.defaultComment("is deprecated")
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	markStructs(structs, func(s *structInfo) *bool { return &s.hasDeprecations }, func(f *fieldInfo) bool {
+		return f.Deprecated != "" || len(f.Aliases) > 0
+	})
+	markStructs(structs, func(s *structInfo) *bool { return &s.hasRenamed }, func(f *fieldInfo) bool {
+		return len(f.Aliases) > 0
+	})
+	return nil
+}
+
+// validateAliases returns errors for aliases that aren't valid json keys, or are
+// the same as a key or another alias of the struct, and for embedded types that
+// have aliases
+func (s *structInfo) validateAliases(name string) definitionErrors {
+	var errs definitionErrors
+	keys := map[string]string{}
+	for _, kn := range s.promotedKeys() {
+		keys[strings.ToLower(kn[0])] = kn[1]
+	}
+	for idx := range s.Fields {
+		f := &s.Fields[idx]
+		if f.embedded {
+			if f.IsStruct() && f.GoType.structDef.HasAliases() {
+				errs = append(errs, s.errorf(name, s.fieldPath(idx, ""),
+					"the embedded type %s of %s has fields with aliases, types with aliases can't be embedded", f.Name, name))
+			}
+			continue
+		}
+		for ai, a := range f.Aliases {
+			path := s.fieldPath(idx, fmt.Sprintf("aliases[%d]", ai))
+			if a == "" || a == "-" || strings.ContainsAny(a, ",\"`") {
+				errs = append(errs, s.errorf(name, path, "alias %q of field %s of %s isn't valid, it can't be empty, - or contain a comma, quote or backtick", a, f.Name, name))
+				continue
+			}
+			k := strings.ToLower(a)
+			if other, exists := keys[k]; exists {
+				errs = append(errs, s.errorf(name, path, "alias %s of field %s of %s is also used by the field %s", a, f.Name, name, other))
+				continue
+			}
+			keys[k] = f.Name
+		}
+	}
+	return errs
+}
+
+// HasAliases returns true if any of the fields of the struct have aliases
+func (s *structInfo) HasAliases() bool {
+	for _, f := range s.Fields {
+		if len(f.Aliases) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDeprecations returns true if the json of the struct can contain deprecated
+// fields or aliases, including in its nested structs
+func (s *structInfo) HasDeprecations() bool {
+	return s.hasDeprecations
+}
+
+// HasDeprecations returns true if the config files can contain deprecated fields or aliases
+func (td *templateData) HasDeprecations() bool {
+	return td.Structs["Configuration"].HasDeprecations()
+}
+
+// HasRenamed returns true if the json of the struct can contain aliases, including
+// in its nested structs
+func (s *structInfo) HasRenamed() bool {
+	return s.hasRenamed
+}
+
+// HasRenamed returns true if the config files can contain aliases, which are replaced
+// with the current keys before the files are decoded
+func (td *templateData) HasRenamed() bool {
+	return td.Structs["Configuration"].HasRenamed()
+}
+
+// RenamedStructFields pipe returns the fields of the struct that are related structs
+// with aliases, including in their nested structs, used by the generated tests
+func (s *structInfo) RenamedStructFields() []*fieldInfo {
+	var res []*fieldInfo
+	for _, f := range s.GetterFields() {
+		if f.IsStruct() && f.GoType.structDef.hasRenamed {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// DeprecatedComment pipe returns the Deprecated paragraph of the comment of the
+// field or its getter, including the leading newline, or an empty string
+func (f *fieldInfo) DeprecatedComment() string {
+	if f.Deprecated == "" {
+		return ""
+	}
+	return "\n//\n// Deprecated: " + f.Deprecated
+}
+
+// DeprecatedKeys pipe returns the keys of the deprecated fields of the struct, and
+// the aliases of its fields, these are the keys that the loaders return warnings for
+func (s *structInfo) DeprecatedKeys() []string {
+	var res []string
+	for _, f := range s.GetterFields() {
+		if f.Deprecated != "" {
+			res = append(res, f.Key())
+		}
+		res = append(res, f.Aliases...)
+	}
+	return res
+}
+
+// RenameAliasesImpl pipe returns the renameAliases method of the struct, which replaces
+// the aliases in the decoded json of the struct with the current keys, before it's
+// decoded, the nested structs that have aliases are renamed recursively
+func (s *structInfo) RenameAliasesImpl() string {
+	n := s.GoType.Name
+	var renames, cases []string
+	for _, f := range s.GetterFields() {
+		if len(f.Aliases) > 0 {
+			args := make([]string, len(f.Aliases))
+			for i, a := range f.Aliases {
+				args[i] = fmt.Sprintf("%q", a)
+			}
+			renames = append(renames, fmt.Sprintf("renamed = renameJSON(m, %q, %s) || renamed", f.Key(), strings.Join(args, ", ")))
+		}
+		nested := f.GoType.structDef
+		if nested == nil || !nested.hasRenamed {
+			continue
+		}
+		stmt := fmt.Sprintf("renamed = new(%s).renameAliases(fv) || renamed", nested.GoType.Name)
+		switch {
+		case f.GoType.IsSlice():
+			stmt = fmt.Sprintf("l, _ := fv.([]interface{})\nfor _, e := range l {\n\trenamed = new(%s).renameAliases(e) || renamed\n}", nested.GoType.Name)
+		case f.GoType.IsMap():
+			stmt = fmt.Sprintf("mv, _ := fv.(map[string]interface{})\nfor _, e := range mv {\n\trenamed = new(%s).renameAliases(e) || renamed\n}", nested.GoType.Name)
+		}
+		cases = append(cases, deprecationsCase([]string{f.Key()}, stmt))
+	}
+	if len(cases) > 0 {
+		// the aliases are renamed first, so that the nested structs set with an alias are also renamed
+		renames = append(renames, fmt.Sprintf("for k, fv := range m {\n\tswitch {\n\t%s\n\t}\n}", strings.Join(cases, "\n")))
+	}
+	return fmt.Sprintf(`// renameAliases replaces the aliases of the renamed fields in v, the decoded json of
+// a %[1]s, with their current key, it returns true if v was changed
+func (c *%[1]s) renameAliases(v interface{}) bool {
+	m, _ := v.(map[string]interface{})
+	var renamed bool
+	%[2]s
+	return renamed
+}`, n, strings.Join(renames, "\n"))
+}
+
+// DeprecationsImpl pipe returns the deprecations method of the struct, which calls
+// warn for each deprecated field or alias that's used in v, the decoded json of the
+// struct, the nested structs that have deprecations are checked recursively
+func (s *structInfo) DeprecationsImpl() string {
+	n := s.GoType.Name
+	var cases []string
+	loop := "for k := range m"
+	for _, f := range s.GetterFields() {
+		nested := f.GoType.structDef
+		recurse := nested != nil && nested.hasDeprecations
+		if f.Deprecated == "" && len(f.Aliases) == 0 && !recurse {
+			continue
+		}
+		var nestedCall string
+		if recurse {
+			loop = "for k, fv := range m"
+			nestedCall = fmt.Sprintf("new(%s).deprecations(path+k+\".\", fv, warn)", nested.GoType.Name)
+			switch {
+			case f.GoType.IsSlice():
+				nestedCall = fmt.Sprintf("l, _ := fv.([]interface{})\nfor i := range l {\n\tnew(%s).deprecations(fmt.Sprintf(\"%%s%%s[%%d].\", path, k, i), l[i], warn)\n}", nested.GoType.Name)
+			case f.GoType.IsMap():
+				// the values are checked in the order of their keys, so that the warnings are in a stable order
+				nestedCall = fmt.Sprintf("mv, _ := fv.(map[string]interface{})\nkeys := make([]string, 0, len(mv))\nfor mk := range mv {\n\tkeys = append(keys, mk)\n}\n"+
+					"sort.Strings(keys)\nfor _, mk := range keys {\n\tnew(%s).deprecations(path+k+\".\"+mk+\".\", mv[mk], warn)\n}", nested.GoType.Name)
+			}
+		}
+		switch {
+		case f.Deprecated != "":
+			cases = append(cases, deprecationsCase(append([]string{f.Key()}, f.Aliases...),
+				fmt.Sprintf("warn(path + k + %q)", " is deprecated: "+f.Deprecated), nestedCall))
+		case len(f.Aliases) > 0:
+			cases = append(cases, deprecationsCase(f.Aliases, fmt.Sprintf("warn(path + k + %q)", " was renamed to "+f.Key()), nestedCall))
+			if recurse {
+				cases = append(cases, deprecationsCase([]string{f.Key()}, nestedCall))
+			}
+		default:
+			cases = append(cases, deprecationsCase([]string{f.Key()}, nestedCall))
+		}
+	}
+	return fmt.Sprintf(`// deprecations calls warn for each deprecated field or alias that's used in v,
+// the decoded json of a %[1]s, path is the path of v in the config file
+func (c *%[1]s) deprecations(path string, v interface{}, warn func(string)) {
+	m, _ := v.(map[string]interface{})
+	%[2]s {
+		switch {
+		%[3]s
+		}
+	}
+}`, n, loop, strings.Join(cases, "\n"))
+}
+
+// deprecationsCase returns a case of the switch in the deprecations method, for the
+// keys of a field, the statements that are empty are skipped
+func deprecationsCase(keys []string, stmts ...string) string {
+	conds := make([]string, len(keys))
+	for i, k := range keys {
+		conds[i] = fmt.Sprintf("strings.EqualFold(k, %q)", k)
+	}
+	body := make([]string, 0, len(stmts))
+	for _, st := range stmts {
+		if st != "" {
+			body = append(body, st)
+		}
+	}
+	return fmt.Sprintf("case %s:\n%s", strings.Join(conds, ", "), strings.Join(body, "\n"))
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Deprecations(t *testing.T) {
+	def, err := loadConfig("testdata/gen_deprecated.json")
+	require.NoError(t, err)
+	assert.True(t, def.HasDeprecations())
+
+	c := def.Structs["Configuration"]
+	assert.True(t, c.HasAliases())
+	assert.True(t, c.HasDeprecations())
+	assert.Equal(t, []string{"Zone", "Address", "listen", "Port", "Timeout", "TimeoutSecs"}, c.DeprecatedKeys())
+	assert.Equal(t, "Port is deprecated", c.Fields[2].Comment, "a deprecated field without a comment should get one")
+	assert.Equal(t, "\n//\n// Deprecated: use BindAddr instead", c.Fields[2].DeprecatedComment())
+	assert.Equal(t, "", c.Fields[1].DeprecatedComment())
+	assert.Contains(t, c.GettersImpl(), "// GetPort is deprecated\n//\n// Deprecated: use BindAddr instead\nfunc (c *Configuration) GetPort() int {")
+
+	ra := c.RenameAliasesImpl()
+	assert.Contains(t, ra, "renamed = renameJSON(m, \"BindAddr\", \"Address\", \"listen\") || renamed")
+	assert.Contains(t, ra, "renamed = renameJSON(m, \"Timeout\", \"TimeoutSecs\") || renamed")
+	assert.Contains(t, ra, "case strings.EqualFold(k, \"TLS\"):\nrenamed = new(TLSInfo).renameAliases(fv) || renamed")
+	assert.Contains(t, ra, "renamed = new(Peer).renameAliases(e) || renamed")
+	assert.True(t, c.HasRenamed())
+	require.Len(t, c.RenamedStructFields(), 1)
+	assert.Equal(t, "TLS", c.RenamedStructFields()[0].Name)
+
+	dp := c.DeprecationsImpl()
+	assert.Contains(t, dp, "case strings.EqualFold(k, \"Address\"), strings.EqualFold(k, \"listen\"):\nwarn(path + k + \" was renamed to BindAddr\")")
+	assert.Contains(t, dp, "case strings.EqualFold(k, \"Timeout\"), strings.EqualFold(k, \"TimeoutSecs\"):\nwarn(path + k + \" is deprecated: the timeouts are set per Peer\")")
+	assert.Contains(t, dp, "case strings.EqualFold(k, \"Zone\"):", "the deprecated fields of embedded types should be included")
+	assert.Contains(t, dp, "new(TLSInfo).deprecations(path+k+\".\", fv, warn)")
+	assert.Contains(t, dp, "new(Peer).deprecations(fmt.Sprintf(\"%s%s[%d].\", path, k, i), l[i], warn)")
+	assert.NotContains(t, dp, "\"ServiceName\"")
+
+	assert.False(t, def.Structs["Service"].HasAliases())
+	assert.False(t, def.Structs["Service"].HasRenamed(), "Service has deprecated fields, but no aliases")
+	assert.True(t, def.Structs["Service"].HasDeprecations())
+	assert.True(t, def.Structs["Peer"].HasDeprecations())
+
+	var sb bytes.Buffer
+	def.sampleConfigurations().writeJSON(&sb, "")
+	sample := sb.String()
+	assert.NotContains(t, sample, "\"Port\"", "deprecated fields shouldn't be in the sample")
+	assert.NotContains(t, sample, "\"Zone\"", "deprecated fields shouldn't be in the sample")
+	assert.Contains(t, sample, "\"BindAddr\"")
+
+	def, err = loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	assert.False(t, def.HasDeprecations())
+}
+
+func Test_InvalidAliases(t *testing.T) {
+	_, err := loadConfig("testdata/invalid_alias_test.json")
+	require.Error(t, err)
+	errs, ok := errors.Cause(err).(definitionErrors)
+	require.True(t, ok, "%T", errors.Cause(err))
+	require.Len(t, errs, 4)
+	assert.Equal(t, "testdata/invalid_alias_test.json:4:22: Configuration.Embeds[0]: the embedded type Common of Configuration has fields with aliases, types with aliases can't be embedded", errs[0].Error())
+	assert.Equal(t, "testdata/invalid_alias_test.json:6:69: Configuration.Fields[0].aliases[0]: alias \"bind,addr\" of field BindAddr of Configuration isn't valid, it can't be empty, - or contain a comma, quote or backtick", errs[1].Error())
+	assert.Equal(t, "testdata/invalid_alias_test.json:6:82: Configuration.Fields[0].aliases[1]: alias name of field BindAddr of Configuration is also used by the field Name", errs[2].Error())
+	assert.Equal(t, "testdata/invalid_alias_test.json:8:69: Configuration.Fields[2].aliases[0]: alias address of field Listen of Configuration is also used by the field Name", errs[3].Error())
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// this file contains the support for related types that embed other related types,
+// e.g. "Embeds" : ["TLSInfo"], the embedded types are generated as anonymous fields,
+// so their fields are promoted in the json and the go struct, and the <Name>Config
+// and <Name>Setter interfaces of the embedded types are included in the interfaces
+// of the embedding type.
+
+// embedFields prepends a field for each of the embedded types to the fields of the
+// struct, these are processed like a field of the related type, but are generated
+// as an anonymous field
+func (s *structInfo) embedFields() {
+	if len(s.Embeds) == 0 || s.embeddedCount() > 0 {
+		return
+	}
+	fields := make([]fieldInfo, 0, len(s.Embeds)+len(s.Fields))
+	for _, e := range s.Embeds {
+		fields = append(fields, fieldInfo{Name: strings.TrimPrefix(e, "[]"), Type: e, embedded: true})
+	}
+	s.Fields = append(fields, s.Fields...)
+}
+
+// embeddedCount returns the number of embedded fields at the start of the Fields
+func (s *structInfo) embeddedCount() int {
+	c := 0
+	for c < len(s.Fields) && s.Fields[c].embedded {
+		c++
+	}
+	return c
+}
+
+// fieldPath returns the json path of the member of the field at idx, relative to the
+// definition of the struct, the path of an embedded field is its entry in the Embeds
+func (s *structInfo) fieldPath(idx int, member string) string {
+	if s.Fields[idx].embedded {
+		return fmt.Sprintf(".Embeds[%d]", idx)
+	}
+	return fmt.Sprintf(".Fields[%d].%s", idx-s.embeddedCount(), member)
+}
+
+// IsEmbedded returns true if the field is an embedded related type
+func (f *fieldInfo) IsEmbedded() bool {
+	return f.embedded
+}
+
+// promotedNames returns the names of the fields of the struct, the fields of
+// the embedded types are included instead of the embedded field
+func (s *structInfo) promotedNames() []string {
+	res := make([]string, 0, len(s.Fields))
+	for _, f := range s.Fields {
+		if f.embedded && f.GoType != nil && f.GoType.structDef != nil {
+			res = append(res, f.GoType.structDef.promotedNames()...)
+		} else {
+			res = append(res, f.Name)
+		}
+	}
+	return res
+}
+
+// GetterFields pipe returns the fields that have a getter, including the fields
+// promoted from the embedded types
+func (s *structInfo) GetterFields() []*fieldInfo {
+	res := make([]*fieldInfo, 0, len(s.Fields))
+	for idx := range s.Fields {
+		f := &s.Fields[idx]
+		if f.embedded {
+			res = append(res, f.GoType.structDef.GetterFields()...)
+		} else {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// DiffPaths pipe returns the paths of the changes that Diff reports for the field,
+// the changes to an embedded type are reported at the paths of its fields
+func (f *fieldInfo) DiffPaths() []string {
+	if f.embedded {
+		return f.GoType.structDef.promotedNames()
+	}
+	return []string{f.Name}
+}
+
+// validateEmbeds returns errors for embedded types that promote a field with the same
+// name as another field of the struct, and for embedded types that don't have the
+// WithGetter and WithSetter options of the struct, as their interfaces are included
+// in the interfaces of the struct
+func (s *structInfo) validateEmbeds(name string) definitionErrors {
+	var errs definitionErrors
+	from := map[string]string{}
+	for idx, f := range s.Fields {
+		if !f.embedded {
+			if e, exists := from[f.Name]; exists {
+				errs = append(errs, s.errorf(name, s.fieldPath(idx, "name"),
+					"field %s of %s clashes with the field of the same name promoted from the embedded type %s", f.Name, name, e))
+			}
+			continue
+		}
+		if !f.IsStruct() {
+			continue
+		}
+		et := f.GoType.structDef
+		path := s.fieldPath(idx, "")
+		if s.WithGetter && !et.WithGetter {
+			errs = append(errs, s.errorf(name, path, "%s is WithGetter, so the embedded type %s must also be WithGetter", name, f.Name))
+		}
+		if s.WithSetter && !et.WithSetter {
+			errs = append(errs, s.errorf(name, path, "%s is WithSetter, so the embedded type %s must also be WithSetter", name, f.Name))
+		}
+		for _, n := range append([]string{f.Name}, et.promotedNames()...) {
+			if e, exists := from[n]; exists {
+				errs = append(errs, s.errorf(name, path,
+					"the embedded type %s of %s promotes the field %s, which is also promoted from %s", f.Name, name, n, e))
+				continue
+			}
+			from[n] = f.Name
+		}
+	}
+	return errs
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Embeds(t *testing.T) {
+	def, err := loadConfig("testdata/gen_embed.json")
+	require.NoError(t, err)
+
+	l := def.Structs["Listener"]
+	require.Len(t, l.Fields, 4)
+	assert.True(t, l.Fields[0].IsEmbedded())
+	assert.True(t, l.Fields[1].IsEmbedded())
+	assert.False(t, l.Fields[2].IsEmbedded())
+	assert.Equal(t, []string{"CertFile", "KeyFile", "ClientAuthType", "ReadTimeout", "WriteTimeout", "BindAddr", "Enabled"}, l.promotedNames())
+	assert.Equal(t, []string{"CertFile", "KeyFile", "ClientAuthType"}, l.Fields[0].DiffPaths())
+	assert.Equal(t, []string{"BindAddr"}, l.Fields[2].DiffPaths())
+	assert.Len(t, l.GetterFields(), 7)
+	assert.Equal(t, `{"BindAddr":":8443","ClientAuthType":"NoClientCert","ReadTimeout":"10s"}`, l.DefaultsJSON())
+
+	assert.Equal(t, "c.TLSInfo.diff(prefix, &o.TLSInfo, changes)", l.Fields[0].DiffImpl())
+	assert.Equal(t, "c.TLSInfo.walk(prefix, fn)", l.Fields[0].WalkImpl())
+	assert.Equal(t, "case \"CertFile\", \"KeyFile\", \"ClientAuthType\":\nreturn c.TLSInfo.getPath(full, path)", l.Fields[0].GetPathImpl())
+	assert.Contains(t, l.GettersImpl(), "type ListenerConfig interface {\nTLSInfoConfig\nTimeoutsConfig\n")
+	assert.Contains(t, l.SettersImpl(), "type ListenerSetter interface {\n\tTLSInfoSetter\n\tTimeoutsSetter\n")
+	assert.NotContains(t, l.SettersImpl(), "SetTLSInfo")
+}
+
+func Test_InvalidEmbeds(t *testing.T) {
+	errsOf := func(fn string) definitionErrors {
+		_, err := loadConfig(fn)
+		require.Error(t, err)
+		errs, ok := errors.Cause(err).(definitionErrors)
+		require.True(t, ok, "%T", errors.Cause(err))
+		return errs
+	}
+	errs := errsOf("testdata/invalid_embed_test.json")
+	require.Len(t, errs, 3)
+	assert.Equal(t, "testdata/invalid_embed_test.json:4:22: Configuration.Embeds[0]: embedded type TLSInfoo of Configuration isn't valid, it must be the name of a related type (did you mean TLSInfo?)", errs[0].Error())
+	assert.Equal(t, "testdata/invalid_embed_test.json:4:47: Configuration.Embeds[2]: embedded type string of Configuration isn't valid, it must be the name of a related type (related types are TLSInfo)", errs[2].Error())
+
+	errs = errsOf("testdata/embed_clash_test.json")
+	require.Len(t, errs, 4)
+	assert.Equal(t, "testdata/embed_clash_test.json:5:33: Configuration.Embeds[1]: Configuration is WithGetter, so the embedded type Peer must also be WithGetter", errs[0].Error())
+	assert.Equal(t, "testdata/embed_clash_test.json:5:33: Configuration.Embeds[1]: the embedded type Peer of Configuration promotes the field CertFile, which is also promoted from TLSInfo", errs[1].Error())
+	assert.Equal(t, "testdata/embed_clash_test.json:7:24: Configuration.Fields[0].name: field KeyFile of Configuration clashes with the field of the same name promoted from the embedded type TLSInfo", errs[2].Error())
+	assert.Contains(t, errs[3].Error(), "field Peer of Configuration clashes with the field of the same name promoted from the embedded type Peer")
+
+	errs = errsOf("testdata/embed_cycle_test.json")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "testdata/embed_cycle_test.json:14:26: RelatedTypes.Base.Embeds[0]: related type Base contains itself: Base.Node -> Node.Base -> Base, use a slice, map or pointer [e.g. []Base or *Base] for a recursive type", errs[0].Error())
+}
+
+func Test_EmbedsSample(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sample")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, generateSample("testdata/gen_embed.json", dir))
+	b, err := ioutil.ReadFile(filepath.Join(dir, "config.sample.json"))
+	require.NoError(t, err)
+	var sample struct {
+		Defaults  map[string]interface{}
+		Overrides map[string]map[string]interface{}
+	}
+	require.NoError(t, json.Unmarshal(b, &sample), string(b))
+	// the fields of the embedded types are promoted
+	assert.Equal(t, "embed", sample.Defaults["ServiceName"])
+	http := sample.Defaults["HTTP"].(map[string]interface{})
+	assert.Equal(t, "NoClientCert", http["ClientAuthType"])
+	assert.Equal(t, ":8443", http["BindAddr"])
+	assert.Equal(t, map[string]interface{}{"ServiceName": "service-name-2"}, sample.Overrides[sampleOverrideName])
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// this file contains the template pipes used to generate the Equal & Diff
+// methods, and their helper functions
+
+// equalExpr returns the source code of the expression that compares the field
+// in the 2 structs, c & o are the names of the variables that point to the structs
+func (f *fieldInfo) equalExpr(c, o string) string {
+	a, b := c+"."+f.Name, o+"."+f.Name
+	if f.GoType.overrideStyle == osStruct {
+		return fmt.Sprintf("%s.Equal(&%s)", a, b)
+	}
+	if ef := f.GoType.EqualFunc(); ef != "" {
+		return fmt.Sprintf("%s(%s, %s)", ef, a, b)
+	}
+	return fmt.Sprintf("%s == %s", a, b)
+}
+
+// DiffImpl pipe returns the statement that appends the changes between the field in c & o
+func (f *fieldInfo) DiffImpl() string {
+	path := fmt.Sprintf("prefix+%q", f.Name)
+	a, b := "c."+f.Name, "o."+f.Name
+	if f.embedded {
+		// the fields of an embedded type are promoted, so they have the same prefix
+		return fmt.Sprintf("%s.diff(prefix, &%s, changes)", a, b)
+	}
+	if f.Secret {
+		return fmt.Sprintf("if !(%s) {\n\t*changes = append(*changes, Change{Path: %s, Old: secretMask, New: secretMask})\n}",
+			f.equalExpr("c", "o"), path)
+	}
+	if f.GoType.overrideStyle == osStruct {
+		return fmt.Sprintf("%s.diff(%s+\".\", &%s, changes)", a, path, b)
+	}
+	if df := f.GoType.DiffFunc(); df != "" {
+		return fmt.Sprintf("%s(%s, %s, %s, changes)", df, path, a, b)
+	}
+	oldVal, newVal := a, b
+	if f.IsBoolPtr() {
+		oldVal, newVal = "boolValue("+a+")", "boolValue("+b+")"
+	}
+	return fmt.Sprintf("if !(%s) {\n\t*changes = append(*changes, Change{Path: %s, Old: %s, New: %s})\n}",
+		f.equalExpr("c", "o"), path, oldVal, newVal)
+}
+
+// EqualImpl pipe returns the body of the generated Equal method
+func (s *structInfo) EqualImpl() string {
+	if len(s.Fields) == 0 {
+		return "return true"
+	}
+	exprs := make([]string, len(s.Fields))
+	for idx := range s.Fields {
+		exprs[idx] = s.Fields[idx].equalExpr("c", "other")
+	}
+	return "return " + strings.Join(exprs, " &&\n\t")
+}
+
+// EqualFunc returns the name of the function that compares 2 values of this type,
+// or an empty string if they can be compared with ==
+func (t *typeInfo) EqualFunc() string {
+	if t.external != nil {
+		if t.external.EqualFunc != "" {
+			return t.external.EqualFunc
+		}
+		return "equalJSON"
+	}
+	if t.IsSlice() || t.IsMap() || t.IsPointer() {
+		return "equal" + strings.TrimPrefix(t.OverrideFunc, "override")
+	}
+	return ""
+}
+
+// DiffFunc returns the name of the generated function that appends the
+// element wise changes between 2 slices of this type, or the changes between
+// 2 maps or pointers of a related type, or an empty string for the other types
+func (t *typeInfo) DiffFunc() string {
+	if t.external != nil || !(t.IsSlice() || t.isIndirect()) {
+		return ""
+	}
+	return "diff" + strings.TrimPrefix(t.OverrideFunc, "override")
+}
+
+// EqualFuncImpl returns the source code of the generated EqualFunc for this
+// type, or an empty string if the type doesn't have a generated EqualFunc
+func (t *typeInfo) EqualFuncImpl() string {
+	ef := t.EqualFunc()
+	if ef == "" || t.external != nil {
+		return ""
+	}
+	switch {
+	case t.IsMap():
+		return fmt.Sprintf("func %s(a, b %s) bool {\n\tif len(a) != len(b) {\n\t\treturn false\n\t}\n"+
+			"\tfor k, av := range a {\n\t\tif bv, exists := b[k]; !exists || !av.Equal(&bv) {\n\t\t\treturn false\n\t\t}\n\t}\n\treturn true\n}", ef, t.Name)
+	case t.IsPointer() && t.structDef != nil:
+		return fmt.Sprintf("func %s(a, b %s) bool {\n\treturn a.Equal(b)\n}", ef, t.Name)
+	case !t.IsSlice():
+		return fmt.Sprintf("func %s(a, b %s) bool {\n\treturn a == b || (a != nil && b != nil && *a == *b)\n}", ef, t.Name)
+	}
+	cmp := "a[i] != b[i]"
+	if t.structDef != nil {
+		cmp = "!a[i].Equal(&b[i])"
+	}
+	return fmt.Sprintf("func %s(a, b %s) bool {\n\tif len(a) != len(b) {\n\t\treturn false\n\t}\n"+
+		"\tfor i := range a {\n\t\tif %s {\n\t\t\treturn false\n\t\t}\n\t}\n\treturn true\n}", ef, t.Name, cmp)
+}
+
+// DiffFuncImpl returns the source code of the generated DiffFunc for this
+// type, or an empty string if the type doesn't have a DiffFunc
+func (t *typeInfo) DiffFuncImpl() string {
+	df := t.DiffFunc()
+	if df == "" {
+		return ""
+	}
+	switch {
+	case t.IsMap():
+		// the values that are only in one of the maps are diffed against the zero value,
+		// the same as the elements of the slices
+		return fmt.Sprintf(`func %[1]s(path string, a, b %[2]s, changes *[]Change) {
+	keys := %[3]s(a)
+	for _, k := range %[3]s(b) {
+		if _, exists := a[k]; !exists {
+			keys = append(keys, k)
+		}
+	}
+	for _, k := range keys {
+		av, bv := a[k], b[k]
+		av.diff(path+"."+k+".", &bv, changes)
+	}
+}`, df, t.Name, t.KeysFunc())
+	case t.IsPointer():
+		return fmt.Sprintf(`func %[1]s(path string, a, b %[2]s, changes *[]Change) {
+	if a == nil && b == nil {
+		return
+	}
+	var zero %[3]s
+	if a == nil {
+		a = &zero
+	}
+	if b == nil {
+		b = &zero
+	}
+	a.diff(path+".", b, changes)
+}`, df, t.Name, t.structDef.GoType.Name)
+	}
+	var r strings.Builder
+	fmt.Fprintf(&r, "func %s(path string, a, b %s, changes *[]Change) {\n", df, t.Name)
+	r.WriteString("\tfor i := 0; i < len(a) || i < len(b); i++ {\n")
+	r.WriteString("\t\tp := fmt.Sprintf(\"%s[%d]\", path, i)\n")
+	if t.structDef != nil {
+		// added & removed elements are diffed against the zero value, so
+		// that each field is reported [and secrets are masked]
+		fmt.Fprintf(&r, "\t\tvar zero %s\n", t.Name[2:])
+		r.WriteString("\t\tswitch {\n")
+		r.WriteString("\t\tcase i >= len(a):\n\t\t\tzero.diff(p+\".\", &b[i], changes)\n")
+		r.WriteString("\t\tcase i >= len(b):\n\t\t\ta[i].diff(p+\".\", &zero, changes)\n")
+		r.WriteString("\t\tdefault:\n\t\t\ta[i].diff(p+\".\", &b[i], changes)\n")
+		r.WriteString("\t\t}\n")
+	} else {
+		r.WriteString("\t\tswitch {\n")
+		r.WriteString("\t\tcase i >= len(a):\n\t\t\t*changes = append(*changes, Change{Path: p, New: b[i]})\n")
+		r.WriteString("\t\tcase i >= len(b):\n\t\t\t*changes = append(*changes, Change{Path: p, Old: a[i]})\n")
+		r.WriteString("\t\tcase a[i] != b[i]:\n\t\t\t*changes = append(*changes, Change{Path: p, Old: a[i], New: b[i]})\n")
+		r.WriteString("\t\t}\n")
+	}
+	r.WriteString("\t}\n}")
+	return r.String()
+}
+
+// UsesEqualJSON returns true if any of the external types are compared via their json encoding
+func (td *templateData) UsesEqualJSON() bool {
+	for _, t := range td.ExternalTypes {
+		if t.EqualFunc() == "equalJSON" {
+			return true
+		}
+	}
+	return false
+}
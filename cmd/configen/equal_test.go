@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EqualExpr(t *testing.T) {
+	def, err := loadConfig("testdata/gen_slices.json")
+	require.NoError(t, err)
+	c := def.Structs["Configuration"]
+	exp := map[string]string{
+		"Services": "equalStrings(c.Services, other.Services)",
+		"Ports":    "equalInts(c.Ports, other.Ports)",
+		"Debug":    "equalBool(c.Debug, other.Debug)",
+		"Peers":    "equalPeerSlice(c.Peers, other.Peers)",
+	}
+	for _, f := range c.Fields {
+		assert.Equal(t, exp[f.Name], f.equalExpr("c", "other"), "field %s", f.Name)
+	}
+	assert.Contains(t, c.EqualImpl(), "return equalStrings(c.Services, other.Services) &&\n")
+
+	peers := def.Structs["Peer"]
+	assert.Equal(t, "c.Name == other.Name", peers.Fields[0].equalExpr("c", "other"))
+	assert.Contains(t, peers.Fields[1].DiffImpl(), `diffStrings(prefix+"URLs", c.URLs, o.URLs, changes)`)
+}
+
+func Test_DiffImpl(t *testing.T) {
+	def, err := loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	etcd := def.Structs["Etcd"]
+	for _, f := range etcd.Fields {
+		if f.Name == "ClusterToken" {
+			require.True(t, f.Secret)
+			assert.Contains(t, f.DiffImpl(), "Old: secretMask, New: secretMask")
+		} else {
+			assert.NotContains(t, f.DiffImpl(), "secretMask")
+		}
+	}
+}
+
+func Test_EqualFuncImpl(t *testing.T) {
+	assert.Empty(t, stdTypesByName["string"].EqualFuncImpl())
+	assert.Empty(t, stdTypesByName["string"].DiffFuncImpl())
+	assert.Contains(t, stdTypesByName["*bool"].EqualFuncImpl(), "func equalBool(a, b *bool) bool")
+	assert.Empty(t, stdTypesByName["*bool"].DiffFuncImpl())
+	assert.Contains(t, stdTypesByName["[]int"].EqualFuncImpl(), "if a[i] != b[i]")
+	assert.Contains(t, stdTypesByName["[]int"].DiffFuncImpl(), "func diffInts(path string, a, b []int, changes *[]Change)")
+
+	ext := &typeInfo{Name: "net.IP", external: &externalTypeDef{}}
+	assert.Equal(t, "equalJSON", ext.EqualFunc())
+	assert.Empty(t, ext.EqualFuncImpl())
+	ext.external.EqualFunc = "ipEqual"
+	assert.Equal(t, "ipEqual", ext.EqualFunc())
+}
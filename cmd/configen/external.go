@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// this file contains the support for external types, these are go types from
+// other packages [e.g. a shared tlsconfig.Info type] that can be used as field
+// types in the definition.
+
+// externalTypeDef describes a go type from another package, the key in the
+// ExternalTypes map is the name used as the field type in the definition
+type externalTypeDef struct {
+	// Import is the import path of the package that contains the type
+	Import string
+	// GoType is the go source for the type, e.g. tlsconfig.Info or *url.URL
+	GoType string
+	// Override specifies how to decide if a value in an override set should be
+	// applied, one of
+	//	zero   : applied if the value is not the zero value of the type, the type must be comparable
+	//	nil    : applied if the value is not nil
+	//	len    : applied if the value has a length > 0
+	//	custom : the MergeFunc is called to apply the override
+	Override string
+	// MergeFunc is the function that applies the override for the custom Override style,
+	// it should have the signature func(d, o *GoType)
+	MergeFunc string `json:",omitempty"`
+	// CloneFunc is an optional function that returns a deep copy of a value, it should have the
+	// signature func(v GoType) GoType, if not set values are copied by assignment
+	CloneFunc string `json:",omitempty"`
+	// EqualFunc is an optional function that compares 2 values, it should have the signature
+	// func(a, b GoType) bool, if not set values are compared via their json encoding
+	EqualFunc string `json:",omitempty"`
+	// Examples contains at least 3 different non zero values of the type, as go
+	// source code, these are used by the generated tests
+	Examples []string
+	// Sample is an optional json value that is used for fields of this type in the sample config files
+	Sample json.RawMessage `json:",omitempty"`
+}
+
+var externalOverrideStyles = map[string]overrideExprType{
+	"zero":   osCompareZeroValue,
+	"nil":    osCompareNil,
+	"len":    osLen,
+	"custom": osCustom,
+}
+
+// validate returns an error if the external type definition is incomplete
+func (e *externalTypeDef) validate(name string) error {
+	if _, isStd := stdTypesByName[name]; isStd {
+		return errors.Errorf("external type %s has the same name as a standard type", name)
+	}
+	if strings.HasPrefix(name, "[]") {
+		return errors.Errorf("external type %s is not a valid name, use the slice type as the GoType instead", name)
+	}
+	if e.Import == "" || e.GoType == "" {
+		return errors.Errorf("external type %s must specify both the Import and GoType", name)
+	}
+	style, ok := externalOverrideStyles[e.Override]
+	if !ok {
+		return errors.Errorf("external type %s has Override %q which isn't valid (valid values are zero,nil,len,custom)", name, e.Override)
+	}
+	if style == osCustom && e.MergeFunc == "" {
+		return errors.Errorf("external type %s has a custom Override, but no MergeFunc", name)
+	}
+	if len(e.Examples) < 3 {
+		return errors.Errorf("external type %s has %d Examples, it should have at least 3", name, len(e.Examples))
+	}
+	return nil
+}
+
+// validateExternalTypes checks all the external type definitions, and creates
+// the typeInfo for each of them
+func (def *configDef) validateExternalTypes() error {
+	def.externalTypeInfos = make(map[string]*typeInfo, len(def.ExternalTypes))
+	names := make([]string, 0, len(def.ExternalTypes))
+	for n := range def.ExternalTypes {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	var errs definitionErrors
+	for _, n := range names {
+		e := def.ExternalTypes[n]
+		if err := e.validate(n); err != nil {
+			errs = append(errs, def.errorf("ExternalTypes."+n, "%v", err))
+			continue
+		}
+		if _, isRelated := def.RelatedTypes[n]; isRelated {
+			errs = append(errs, def.errorf("ExternalTypes."+n, "external type %s has the same name as a related type", n))
+			continue
+		}
+		t := &typeInfo{
+			Name:          e.GoType,
+			OverrideFunc:  "override" + n,
+			overrideStyle: externalOverrideStyles[e.Override],
+			ExampleValues: e.Examples,
+			external:      e,
+		}
+		if t.overrideStyle == osCustom {
+			t.OverrideFunc = e.MergeFunc
+		}
+		def.externalTypeInfos[n] = t
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExternalTypes(t *testing.T) {
+	examples := []string{"a.X{1}", "a.X{2}", "a.X{3}"}
+	def := &configDef{
+		Configuration: &structInfo{Fields: []fieldInfo{{Name: "A", Type: "Info"}, {Name: "B", Type: "Names"}, {Name: "C", Type: "string"}}},
+		ExternalTypes: map[string]*externalTypeDef{
+			"Info":   {Import: "example.com/tlsconfig", GoType: "tlsconfig.Info", Override: "custom", MergeFunc: "tlsconfig.Merge", Examples: examples},
+			"Names":  {Import: "example.com/names", GoType: "names.List", Override: "len", Examples: examples},
+			"Unused": {Import: "example.com/unused", GoType: "unused.X", Override: "zero", Examples: examples},
+		},
+	}
+	td, err := def.processConfig()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com/names", "example.com/tlsconfig"}, td.Imports)
+	assert.Equal(t, []string{"example.com/names"}, td.ExtraImports("example.com/tlsconfig", "time"))
+	require.Len(t, td.ExternalTypes, 2)
+
+	fields := td.Structs["Configuration"].Fields
+	assert.Equal(t, "tlsconfig.Merge(&c.A, &o.A)", fields[0].OverrideImpl())
+	assert.Equal(t, "overrideNames(&c.B, &o.B)", fields[1].OverrideImpl())
+	assert.Equal(t, "names.List", fields[1].GoType.Name)
+	assert.Equal(t, "len(*o) > 0", fields[1].GoType.OverrideExpr())
+	assert.Equal(t, "*o != *new(unused.X)", def.externalTypeInfos["Unused"].OverrideExpr())
+
+	// the custom merge func is not generated
+	baseNames := []string{}
+	for _, bt := range td.BaseTypes {
+		baseNames = append(baseNames, bt.Name)
+	}
+	assert.Equal(t, []string{"names.List", "string"}, baseNames)
+}
+
+func Test_ExternalTypesInvalid(t *testing.T) {
+	examples := []string{"1", "2", "3"}
+	tcases := []struct {
+		name string
+		def  *externalTypeDef
+		exp  string
+	}{
+		{"string", &externalTypeDef{Import: "a", GoType: "a.X", Override: "len", Examples: examples}, "external type string has the same name as a standard type"},
+		{"[]X", &externalTypeDef{Import: "a", GoType: "a.X", Override: "len", Examples: examples}, "external type []X is not a valid name, use the slice type as the GoType instead"},
+		{"X", &externalTypeDef{GoType: "a.X", Override: "len", Examples: examples}, "external type X must specify both the Import and GoType"},
+		{"X", &externalTypeDef{Import: "a", GoType: "a.X", Override: "bob", Examples: examples}, `external type X has Override "bob" which isn't valid (valid values are zero,nil,len,custom)`},
+		{"X", &externalTypeDef{Import: "a", GoType: "a.X", Override: "custom", Examples: examples}, "external type X has a custom Override, but no MergeFunc"},
+		{"X", &externalTypeDef{Import: "a", GoType: "a.X", Override: "nil", Examples: examples[:2]}, "external type X has 2 Examples, it should have at least 3"},
+	}
+	for _, tc := range tcases {
+		err := tc.def.validate(tc.name)
+		require.Error(t, err)
+		assert.Equal(t, tc.exp, err.Error())
+	}
+
+	def := &configDef{
+		Configuration: &structInfo{Fields: []fieldInfo{{Name: "A", Type: "X"}}},
+		RelatedTypes:  map[string]*structInfo{"X": {Fields: []fieldInfo{{Name: "A", Type: "string"}}}},
+		ExternalTypes: map[string]*externalTypeDef{"X": {Import: "a", GoType: "a.X", Override: "len", Examples: examples}},
+	}
+	_, err := def.processConfig()
+	require.Error(t, err)
+	assert.Equal(t, "ExternalTypes.X: external type X has the same name as a related type", err.Error())
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// this file contains the support for fields that can't be overridden, a field with
+// "overridable" : false has the same value for all the hosts, the generated loaders
+// return an error for the override sets and layers that set it.
+
+// IsFixed returns true if the field is marked as not overridable
+func (f *fieldInfo) IsFixed() bool {
+	return f.Overridable != nil && !*f.Overridable
+}
+
+// applyFixed records which structs have fields that can't be overridden, including
+// those of their nested structs
+func applyFixed(structs map[string]*structInfo) {
+	for _, s := range structs {
+		for idx := range s.Fields {
+			if f := &s.Fields[idx]; f.IsFixed() {
+				f.defaultComment("is the same for all the hosts")
+			}
+		}
+	}
+	markStructs(structs, func(s *structInfo) *bool { return &s.hasFixed }, (*fieldInfo).IsFixed)
+}
+
+// HasFixed returns true if the struct has fields that can't be overridden, including
+// in its nested structs
+func (s *structInfo) HasFixed() bool {
+	return s.hasFixed
+}
+
+// HasFixed returns true if the Configuration has fields that can't be overridden
+func (td *templateData) HasFixed() bool {
+	return td.Structs["Configuration"].HasFixed()
+}
+
+// FixedComment pipe returns the paragraph of the comment of the field or its getter
+// that says it can't be overridden, including the leading newline, or an empty string
+func (f *fieldInfo) FixedComment() string {
+	if !f.IsFixed() {
+		return ""
+	}
+	return fmt.Sprintf("\n//\n// %s isn't overridable, it can only be set in the Defaults.", f.Name)
+}
+
+// FixedFields pipe returns the fields of the struct that can't be overridden,
+// including the fields promoted from the embedded types
+func (s *structInfo) FixedFields() []*fieldInfo {
+	var res []*fieldInfo
+	for _, f := range s.GetterFields() {
+		if f.IsFixed() {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// FixedSetImpl pipe returns the fixedSet method of the struct, which returns the
+// paths of the fields that can't be overridden, and are set in the override set, the
+// nested structs that have such fields are checked recursively
+func (s *structInfo) FixedSetImpl() string {
+	n := s.GoType.Name
+	var stmts []string
+	for _, f := range s.GetterFields() {
+		nested := f.GoType.structDef
+		switch {
+		case f.IsFixed():
+			stmts = append(stmts, fmt.Sprintf("if !(%s) {\n\tres = append(res, path+%q)\n}", f.equalExpr("c", "zero"), f.Name))
+		case nested == nil || !nested.hasFixed:
+		case f.GoType.IsSlice():
+			stmts = append(stmts, fmt.Sprintf(`for i := range c.%[1]s {
+	res = append(res, c.%[1]s[i].fixedSet(fmt.Sprintf("%%s%[1]s[%%d].", path, i))...)
+}`, f.Name))
+		case f.GoType.IsMap():
+			stmts = append(stmts, fmt.Sprintf(`for _, k := range %[2]s(c.%[1]s) {
+	v := c.%[1]s[k]
+	res = append(res, v.fixedSet(path+%[3]q+k+".")...)
+}`, f.Name, f.GoType.KeysFunc(), f.Name+"."))
+		case f.GoType.IsPointer():
+			stmts = append(stmts, fmt.Sprintf("if c.%[1]s != nil {\n\tres = append(res, c.%[1]s.fixedSet(path+%[2]q)...)\n}", f.Name, f.Name+"."))
+		default:
+			stmts = append(stmts, fmt.Sprintf("res = append(res, c.%s.fixedSet(path+%q)...)", f.Name, f.Name+"."))
+		}
+	}
+	return fmt.Sprintf(`// fixedSet returns the path of each field that can't be overridden, and is set
+// in the override set c, path is the path of c in the Configuration
+func (c *%s) fixedSet(path string) []string {
+	var res []string
+	%s%s
+	return res
+}`, n, s.fixedZero(), strings.Join(stmts, "\n"))
+}
+
+// ClearFixedImpl pipe returns the clearFixed method of the struct that's used by the
+// generated tests, it clears the fields that can't be overridden, so that an override
+// set with the result is valid
+func (s *structInfo) ClearFixedImpl() string {
+	n := s.GoType.Name
+	var stmts []string
+	for _, f := range s.GetterFields() {
+		nested := f.GoType.structDef
+		switch {
+		case f.IsFixed():
+			stmts = append(stmts, fmt.Sprintf("c.%[1]s = zero.%[1]s", f.Name))
+		case nested == nil || !nested.hasFixed:
+		case f.GoType.IsSlice():
+			stmts = append(stmts, fmt.Sprintf("for i := range c.%[1]s {\n\tc.%[1]s[i].clearFixed()\n}", f.Name))
+		case f.GoType.IsMap():
+			stmts = append(stmts, fmt.Sprintf("for k, v := range c.%[1]s {\n\tv.clearFixed()\n\tc.%[1]s[k] = v\n}", f.Name))
+		case f.GoType.IsPointer():
+			stmts = append(stmts, fmt.Sprintf("if c.%[1]s != nil {\n\tc.%[1]s.clearFixed()\n}", f.Name))
+		default:
+			stmts = append(stmts, fmt.Sprintf("c.%s.clearFixed()", f.Name))
+		}
+	}
+	return fmt.Sprintf(`// clearFixed sets the fields of c that can't be overridden to their zero value
+func (c *%s) clearFixed() {
+	%s%s
+}`, n, s.fixedZero(), strings.Join(stmts, "\n"))
+}
+
+// fixedZero returns the declaration of the zero value that the fields that can't be
+// overridden are compared to, or an empty string if the struct has no such fields
+func (s *structInfo) fixedZero() string {
+	if len(s.FixedFields()) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("var zero %s\n", s.GoType.Name)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Fixed(t *testing.T) {
+	def, err := loadConfig("testdata/gen_fixed.json")
+	require.NoError(t, err)
+	assert.True(t, def.HasFixed())
+
+	c := def.Structs["Configuration"]
+	assert.True(t, c.HasFixed())
+	fixed := []string{}
+	for _, f := range c.FixedFields() {
+		fixed = append(fixed, f.Name)
+	}
+	assert.Equal(t, []string{"ServiceName", "DataDir", "Audit"}, fixed, "the promoted fields should be included")
+	assert.Equal(t, "DataDir is the same for all the hosts", c.Fields[2].Comment, "a fixed field without a comment should get one")
+	assert.Equal(t, "\n//\n// DataDir isn't overridable, it can only be set in the Defaults.", c.Fields[2].FixedComment())
+	assert.Equal(t, "", c.Fields[1].FixedComment())
+	assert.Contains(t, c.GettersImpl(), "// GetDataDir is the same for all the hosts\n//\n// DataDir isn't overridable, it can only be set in the Defaults.\nfunc (c *Configuration) GetDataDir() string {")
+
+	fs := c.FixedSetImpl()
+	assert.Contains(t, fs, "var zero Configuration\n")
+	assert.Contains(t, fs, "if !(c.DataDir == zero.DataDir) {\n\tres = append(res, path+\"DataDir\")\n}")
+	assert.Contains(t, fs, "if !(c.Audit.Equal(&zero.Audit)) {\n\tres = append(res, path+\"Audit\")\n}")
+	assert.Contains(t, fs, "res = append(res, c.Etcd.fixedSet(path+\"Etcd.\")...)")
+	assert.Contains(t, fs, "res = append(res, c.Peers[i].fixedSet(fmt.Sprintf(\"%sPeers[%d].\", path, i))...)")
+	assert.NotContains(t, fs, "BindAddr")
+
+	cf := c.ClearFixedImpl()
+	assert.Contains(t, cf, "c.DataDir = zero.DataDir")
+	assert.Contains(t, cf, "c.Etcd.clearFixed()")
+	assert.Contains(t, cf, "c.Peers[i].clearFixed()")
+
+	assert.True(t, def.Structs["Etcd"].HasFixed())
+	assert.False(t, def.Structs["Etcd"].Fields[2].IsFixed(), "overridable : true is the default")
+	assert.True(t, def.Structs["Peer"].HasFixed())
+	assert.False(t, def.Structs["Audit"].HasFixed(), "only the Audit field of the Configuration is fixed, not the fields of the Audit type")
+
+	var sb bytes.Buffer
+	def.sampleConfigurations().writeJSON(&sb, "")
+	sample := sb.String()
+	assert.Contains(t, sample, "\"example\": {\n      \"Region\":", "the sample override should skip the fields that can't be overridden")
+
+	def, err = loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	assert.False(t, def.HasFixed())
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+)
+
+// this file contains the template pipes used to generate the Holder type, which
+// holds the current Configuration and allows it to be replaced while in use
+
+// SubscribeImpl pipe returns the Holder method that subscribes to changes of this
+// field of the Configuration, e.g. OnHTTPChange(fn func(prev, next *HTTPServer))
+func (f *fieldInfo) SubscribeImpl() string {
+	typ, args := f.GoType.Name, fmt.Sprintf("prev.%s, next.%s", f.Name, f.Name)
+	if f.IsStruct() {
+		typ, args = "*"+f.GoType.Name, fmt.Sprintf("&prev.%s, &next.%s", f.Name, f.Name)
+	}
+	return fmt.Sprintf(`// On%[1]sChange registers fn to be called by Swap when the %[1]s value changes,
+// it returns a function that cancels the subscription
+func (h *Holder) On%[1]sChange(fn func(prev, next %[2]s)) func() {
+	return h.subscribe(func(prev, next *Configuration) {
+		if !(%[3]s) {
+			fn(%[4]s)
+		}
+	})
+}`, f.Name, typ, f.equalExpr("prev", "next"), args)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SubscribeImpl(t *testing.T) {
+	def, err := loadConfig("testdata/gen_slices.json")
+	require.NoError(t, err)
+	for _, f := range def.Structs["Configuration"].Fields {
+		if f.Name == "Debug" {
+			impl := f.SubscribeImpl()
+			assert.Contains(t, impl, "func (h *Holder) OnDebugChange(fn func(prev, next *bool)) func() {")
+			assert.Contains(t, impl, "if !(equalBool(prev.Debug, next.Debug)) {")
+			assert.Contains(t, impl, "fn(prev.Debug, next.Debug)")
+		}
+	}
+
+	def, err = loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	for _, f := range def.Structs["Configuration"].Fields {
+		if f.Name == "Etcd" {
+			impl := f.SubscribeImpl()
+			assert.Contains(t, impl, "func (h *Holder) OnEtcdChange(fn func(prev, next *Etcd)) func() {")
+			assert.Contains(t, impl, "if !(prev.Etcd.Equal(&next.Etcd)) {")
+			assert.Contains(t, impl, "fn(&prev.Etcd, &next.Etcd)")
+		}
+	}
+}
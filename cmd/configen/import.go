@@ -0,0 +1,361 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// this file contains the import command, this reverse generates a configuration
+// definition file from existing hand written go structs, so that existing
+// config packages can be migrated to configen.
+
+// runImport implements the import command
+// usage configen import -pkg <package> [-type <type name>] [-o <config_def.json>]
+func runImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	pkg := flags.String("pkg", ".", "Go package to import the configuration types from")
+	typ := flags.String("type", "Configuration", "Name of the top level configuration type")
+	out := flags.String("o", "", "Filename to write the configuration definition to, defaults to stdout")
+	flags.Parse(args)
+
+	def, err := importDefinition(*pkg, *typ)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	b, err := json.MarshalIndent(def, "", "    ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	b = append(b, '\n')
+	if *out == "" {
+		_, err = os.Stdout.Write(b)
+		return errors.Trace(err)
+	}
+	return errors.Trace(ioutil.WriteFile(*out, b, 0664))
+}
+
+// defImporter walks the struct graph starting at the top level configuration
+// type, and builds up the configDef from it
+type defImporter struct {
+	pkg *types.Package
+	// files and info are the parsed & type checked source of the package
+	files []*ast.File
+	info  *types.Info
+	def   *configDef
+	// top is the go type that is mapped to the Configuration type
+	top *types.Named
+	// docs contains the doc comments for the struct fields and type declarations
+	docs map[types.Object]string
+	// sources tracks the go type that each related type was created from, to detect name collisions
+	sources map[string]*types.Named
+	// unsupported contains an entry for each field that can't be mapped to a configen type
+	unsupported []string
+}
+
+// importDefinition loads the go package and builds a configuration definition
+// from the named struct type and all the struct types it references
+func importDefinition(pattern, typeName string) (*configDef, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	bp, err := build.Import(pattern, cwd, 0)
+	if err != nil {
+		return nil, errors.Errorf("unable to find package %s: %v", pattern, err)
+	}
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(bp.GoFiles))
+	for _, fn := range bp.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(bp.Dir, fn), nil, parser.ParseComments)
+		if err != nil {
+			return nil, errors.Errorf("unable to parse package %s: %v", pattern, err)
+		}
+		files = append(files, f)
+	}
+	info := &types.Info{Defs: map[*ast.Ident]types.Object{}}
+	tc := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := tc.Check(bp.ImportPath, fset, files, info)
+	if err != nil {
+		return nil, errors.Errorf("unable to load package %s: %v", pattern, err)
+	}
+
+	obj := pkg.Scope().Lookup(typeName)
+	if obj == nil {
+		return nil, errors.Errorf("type %s not found in package %s", typeName, pkg.Path())
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok || !isStruct(named) {
+		return nil, errors.Errorf("type %s in package %s is not a struct", typeName, pkg.Path())
+	}
+
+	imp := &defImporter{
+		pkg:     pkg,
+		files:   files,
+		info:    info,
+		def:     &configDef{PackageName: pkg.Name(), RelatedTypes: map[string]*structInfo{}},
+		docs:    map[types.Object]string{},
+		sources: map[string]*types.Named{},
+		top:     named,
+	}
+	imp.collectDocs()
+	imp.def.Configuration = imp.importStruct(named)
+	if len(imp.unsupported) > 0 {
+		return nil, errors.Errorf("unable to import %s, the following fields have unsupported types:\n\t%s",
+			typeName, strings.Join(imp.unsupported, "\n\t"))
+	}
+	if len(imp.def.RelatedTypes) == 0 {
+		imp.def.RelatedTypes = nil
+	}
+	return imp.def, nil
+}
+
+// collectDocs populates the docs map from the doc comments on the type
+// declarations and struct fields in the package source
+func (imp *defImporter) collectDocs() {
+	for _, file := range imp.files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch tn := n.(type) {
+			case *ast.GenDecl:
+				for _, spec := range tn.Specs {
+					ts, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					doc := ts.Doc
+					if doc == nil && len(tn.Specs) == 1 {
+						doc = tn.Doc
+					}
+					if obj := imp.info.Defs[ts.Name]; obj != nil && doc != nil {
+						imp.docs[obj] = strings.TrimSpace(doc.Text())
+					}
+				}
+			case *ast.Field:
+				doc := tn.Doc
+				if doc == nil {
+					doc = tn.Comment
+				}
+				if doc == nil {
+					return true
+				}
+				for _, name := range tn.Names {
+					if obj := imp.info.Defs[name]; obj != nil {
+						imp.docs[obj] = strings.TrimSpace(doc.Text())
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+// importStruct returns the structInfo for the go struct type, any struct types
+// referenced by its fields are added to the RelatedTypes
+func (imp *defImporter) importStruct(named *types.Named) *structInfo {
+	st := named.Underlying().(*types.Struct)
+	res := &structInfo{
+		commentable: commentable{Comment: imp.docs[named.Obj()]},
+		WithGetter:  hasGetters(named),
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if !f.Exported() {
+			continue
+		}
+		where := fmt.Sprintf("%s.%s", named.Obj().Name(), f.Name())
+		if f.Anonymous() {
+			named, ok := f.Type().(*types.Named)
+			if !ok || !isStruct(named) {
+				imp.unsupported = append(imp.unsupported, fmt.Sprintf("%s: embedded type %s is not supported, only structs can be embedded",
+					where, types.TypeString(f.Type(), imp.qualifier)))
+				continue
+			}
+			rt, err := imp.relatedType(named)
+			if err != nil {
+				imp.unsupported = append(imp.unsupported, fmt.Sprintf("%s: %v", where, err))
+				continue
+			}
+			res.Embeds = append(res.Embeds, rt)
+			continue
+		}
+		tag := reflect.StructTag(st.Tag(i))
+		if tag.Get("json") == "-" {
+			// the field isn't read from the config files
+			continue
+		}
+		ft, err := imp.fieldType(f.Type())
+		if err != nil {
+			imp.unsupported = append(imp.unsupported, fmt.Sprintf("%s: %v", where, err))
+			continue
+		}
+		field := fieldInfo{
+			commentable: commentable{Comment: imp.docs[f]},
+			Name:        f.Name(),
+			Type:        ft,
+		}
+		field.importTags(tag)
+		res.Fields = append(res.Fields, field)
+	}
+	return res
+}
+
+// importedTags are the struct tags, other than json, that are imported into the tags of the fields
+var importedTags = []string{"yaml", "toml", "env"}
+
+// importTags sets the json key, omitempty and tags of the field from its struct tag, so
+// that the generated config reads the same config files as the hand written struct
+func (f *fieldInfo) importTags(tag reflect.StructTag) {
+	if v, ok := tag.Lookup("json"); ok {
+		opts := strings.Split(v, ",")
+		if opts[0] != "" && opts[0] != f.Name {
+			f.JSON = opts[0]
+		}
+		for _, o := range opts[1:] {
+			f.OmitEmpty = f.OmitEmpty || o == "omitempty"
+		}
+	}
+	for _, t := range importedTags {
+		v, ok := tag.Lookup(t)
+		if !ok {
+			continue
+		}
+		if f.Tags == nil {
+			f.Tags = map[string]string{}
+		}
+		// the omitempty option is added by the generator when the field is OmitEmpty
+		f.Tags[t] = strings.Split(v, ",")[0]
+	}
+}
+
+// fieldType maps the go type onto one of the stdTypes or a related type
+func (imp *defImporter) fieldType(t types.Type) (string, error) {
+	switch tt := t.(type) {
+	case *types.Basic:
+		if tt.Kind() == types.Bool {
+			return "", errors.Errorf("type bool is not supported, use *bool instead")
+		}
+		if _, ok := stdTypesByName[tt.Name()]; ok {
+			return tt.Name(), nil
+		}
+	case *types.Pointer:
+		if b, ok := tt.Elem().(*types.Basic); ok && b.Kind() == types.Bool {
+			return "*bool", nil
+		}
+		if named, ok := tt.Elem().(*types.Named); ok && isStruct(named) {
+			rt, err := imp.relatedType(named)
+			if err != nil {
+				return "", errors.Errorf("type %s is not supported: %v", types.TypeString(t, imp.qualifier), err)
+			}
+			return "*" + rt, nil
+		}
+	case *types.Map:
+		// only maps of related types with string keys are supported
+		key, isBasic := tt.Key().(*types.Basic)
+		named, isNamed := tt.Elem().(*types.Named)
+		if isBasic && key.Kind() == types.String && isNamed && isStruct(named) {
+			rt, err := imp.relatedType(named)
+			if err != nil {
+				return "", errors.Errorf("type %s is not supported: %v", types.TypeString(t, imp.qualifier), err)
+			}
+			return "map[string]" + rt, nil
+		}
+	case *types.Slice:
+		et, err := imp.fieldType(tt.Elem())
+		if err != nil {
+			return "", errors.Errorf("type %s is not supported: %v", types.TypeString(t, imp.qualifier), err)
+		}
+		if _, ok := stdTypesByName["[]"+et]; ok {
+			return "[]" + et, nil
+		}
+		if _, ok := imp.def.RelatedTypes[et]; ok {
+			return "[]" + et, nil
+		}
+	case *types.Named:
+		if tt.Obj().Name() == "Duration" {
+			if b, ok := tt.Underlying().(*types.Basic); ok && b.Kind() == types.Int64 {
+				return "Duration", nil
+			}
+		}
+		if isStruct(tt) {
+			return imp.relatedType(tt)
+		}
+		if b, ok := tt.Underlying().(*types.Basic); ok {
+			if _, isStd := stdTypesByName[b.Name()]; isStd {
+				return "", errors.Errorf("type %s is not supported, use %s instead", types.TypeString(t, imp.qualifier), b.Name())
+			}
+		}
+	}
+	return "", errors.Errorf("type %s is not supported", types.TypeString(t, imp.qualifier))
+}
+
+// relatedType returns the name of the related type for the go struct type,
+// importing it if this is the first time its been seen
+func (imp *defImporter) relatedType(named *types.Named) (string, error) {
+	name := named.Obj().Name()
+	if named.Obj().Pkg() != imp.pkg {
+		return "", errors.Errorf("type %s is not supported, the types from other packages can be added to the ExternalTypes of the definition",
+			types.TypeString(named, imp.qualifier))
+	}
+	if named == imp.top {
+		return "", errors.Errorf("references to the top level configuration type %s are not supported", name)
+	}
+	if name == "Configuration" {
+		return "", errors.Errorf("type %s can't be a related type, Configuration is reserved for the top level type",
+			types.TypeString(named, imp.qualifier))
+	}
+	if src, exists := imp.sources[name]; exists {
+		if src != named {
+			return "", errors.Errorf("type %s conflicts with the type %s, related type names must be unique",
+				types.TypeString(named, imp.qualifier), types.TypeString(src, imp.qualifier))
+		}
+		return name, nil
+	}
+	imp.sources[name] = named
+	// add a placeholder first, so that recursive references resolve
+	imp.def.RelatedTypes[name] = &structInfo{}
+	*imp.def.RelatedTypes[name] = *imp.importStruct(named)
+	return name, nil
+}
+
+func (imp *defImporter) qualifier(p *types.Package) string {
+	if p == imp.pkg {
+		return ""
+	}
+	return p.Name()
+}
+
+func isStruct(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Struct)
+	return ok
+}
+
+// hasGetters returns true if the type has a Get method for its first exported
+// field, which indicates it was written with getters [like the WithGetter option
+// generates]
+func hasGetters(named *types.Named) bool {
+	st := named.Underlying().(*types.Struct)
+	ms := types.NewMethodSet(types.NewPointer(named))
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if !f.Exported() {
+			continue
+		}
+		return ms.Lookup(named.Obj().Pkg(), "Get"+f.Name()) != nil ||
+			ms.Lookup(named.Obj().Pkg(), "Get"+f.Name()+"Cfg") != nil
+	}
+	return false
+}
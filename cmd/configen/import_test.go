@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ImportDefinition(t *testing.T) {
+	def, err := importDefinition("./testdata/importsrc", "Config")
+	require.NoError(t, err)
+
+	assert.Equal(t, "importsrc", def.PackageName)
+	require.NotNil(t, def.Configuration)
+	assert.Equal(t, "Config contains the service configuration", def.Configuration.Comment)
+	assert.False(t, def.Configuration.WithGetter)
+	assert.Equal(t, []fieldInfo{
+		{commentable: commentable{"HTTP contains the config for the HTTP service"}, Name: "HTTP", Type: "HTTPServer"},
+		{commentable: commentable{"LogLevels specifies the log levels per package"}, Name: "LogLevels", Type: "[]RepoLogLevel"},
+		{commentable: commentable{"Debug enables the debug mode"}, Name: "Debug", Type: "*bool"},
+	}, def.Configuration.Fields)
+
+	require.Len(t, def.RelatedTypes, 2)
+	http := def.RelatedTypes["HTTPServer"]
+	require.NotNil(t, http)
+	assert.True(t, http.WithGetter)
+	assert.Equal(t, "HTTPServer contains the configuration of the HTTP service", http.Comment)
+	types := map[string]string{}
+	for _, f := range http.Fields {
+		types[f.Name] = f.Type
+	}
+	assert.Equal(t, map[string]string{
+		"BindAddr":  "string",
+		"Services":  "[]string",
+		"Timeout":   "Duration",
+		"Heartbeat": "Duration",
+		"MaxConns":  "int64",
+		"Ratio":     "float64",
+	}, types)
+	assert.Contains(t, def.RelatedTypes, "RepoLogLevel")
+
+	// the imported definition should be usable to generate the config
+	dir, err := ioutil.TempDir("", "import")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	b, err := json.Marshal(def)
+	require.NoError(t, err)
+	defFile := filepath.Join(dir, "def.json")
+	require.NoError(t, ioutil.WriteFile(defFile, b, 0664))
+
+	td, err := loadConfig(defFile)
+	require.NoError(t, err)
+	assert.Len(t, td.Structs, 3)
+}
+
+func Test_ImportTags(t *testing.T) {
+	def, err := importDefinition("./testdata/importsrc", "Tagged")
+	require.NoError(t, err)
+	assert.Equal(t, []fieldInfo{
+		{commentable: commentable{"BindAddr is the address to listen on"}, Name: "BindAddr", Type: "string",
+			JSON: "bind_addr", OmitEmpty: true, Tags: map[string]string{"yaml": "bind_addr", "env": "LISTEN_ADDR"}},
+		{commentable: commentable{"Port to listen on"}, Name: "Port", Type: "int", OmitEmpty: true, Tags: map[string]string{"toml": "port"}},
+		{commentable: commentable{"Region the service runs in"}, Name: "Region", Type: "string"},
+	}, def.Configuration.Fields, "the fields with json:\"-\" aren't read from the config files, so they're skipped")
+
+	dir, err := ioutil.TempDir("", "import")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	b, err := json.Marshal(def)
+	require.NoError(t, err)
+	defFile := filepath.Join(dir, "def.json")
+	require.NoError(t, ioutil.WriteFile(defFile, b, 0664))
+
+	td, err := loadConfig(defFile)
+	require.NoError(t, err)
+	fields := td.Structs["Configuration"].Fields
+	assert.Equal(t, " `json:\"bind_addr,omitempty\" env:\"LISTEN_ADDR\" yaml:\"bind_addr,omitempty\"`", fields[0].Tag())
+	assert.Equal(t, " `json:\"Port,omitempty\" toml:\"port,omitempty\"`", fields[1].Tag())
+	assert.Equal(t, "", fields[2].Tag())
+}
+
+func Test_ImportRecursive(t *testing.T) {
+	def, err := importDefinition("./testdata/importsrc", "Router")
+	require.NoError(t, err)
+	assert.Equal(t, []fieldInfo{
+		{commentable: commentable{"Routes are the routes by name"}, Name: "Routes", Type: "map[string]Route"},
+		{commentable: commentable{"Default is used when none of the Routes match"}, Name: "Default", Type: "*Route"},
+	}, def.Configuration.Fields)
+	require.Contains(t, def.RelatedTypes, "Route")
+	types := map[string]string{}
+	for _, f := range def.RelatedTypes["Route"].Fields {
+		types[f.Name] = f.Type
+	}
+	assert.Equal(t, map[string]string{
+		"Prefix":   "string",
+		"Children": "[]Route",
+		"Variants": "map[string]Route",
+		"Fallback": "*Route",
+	}, types)
+
+	dir, err := ioutil.TempDir("", "import")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	b, err := json.Marshal(def)
+	require.NoError(t, err)
+	defFile := filepath.Join(dir, "def.json")
+	require.NoError(t, ioutil.WriteFile(defFile, b, 0664))
+
+	_, err = loadConfig(defFile)
+	require.NoError(t, err)
+}
+
+func Test_ImportUnsupported(t *testing.T) {
+	_, err := importDefinition("./testdata/importsrc", "Broken")
+	require.Error(t, err)
+	assert.Equal(t, "unable to import Broken, the following fields have unsupported types:\n"+
+		"\tBroken.Enabled: type bool is not supported, use *bool instead\n"+
+		"\tBroken.Labels: type map[string]string is not supported\n"+
+		"\tBroken.Levels: type []*bool is not supported\n"+
+		"\tBroken.Count: type int32 is not supported\n"+
+		"\tBroken.Parent: type *Broken is not supported: references to the top level configuration type Broken are not supported\n"+
+		"\tBroken.Started: type time.Time is not supported, the types from other packages can be added to the ExternalTypes of the definition\n"+
+		"\tBroken.HTTPServer: embedded type *HTTPServer is not supported, only structs can be embedded", err.Error())
+
+	def, err := importDefinition("./testdata/importsrc", "Listener")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HTTPServer"}, def.Configuration.Embeds)
+	assert.Contains(t, def.RelatedTypes, "HTTPServer")
+
+	_, err = importDefinition("./testdata/importsrc", "Missing")
+	require.Error(t, err)
+	assert.Equal(t, "type Missing not found in package ./testdata/importsrc", err.Error())
+
+	_, err = importDefinition("./testdata/importsrc", "Duration")
+	require.Error(t, err)
+	assert.Equal(t, "type Duration in package ./testdata/importsrc is not a struct", err.Error())
+}
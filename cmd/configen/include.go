@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// this file contains the support for including other definition files, this
+// allows for a shared library of related types [e.g. TLSInfo] to be defined once
+// and used from the definitions of many services.
+
+// includeDef describes another definition file whose related types are merged
+// into the including definition. In the definition file it can be just the
+// filename, or an object with the File and optional Prefix & Alias settings.
+type includeDef struct {
+	// File is the filename of the included definition, relative paths are
+	// resolved relative to the including file
+	File string
+	// Prefix if set is prepended to the names of all the included types
+	Prefix string `json:",omitempty"`
+	// Alias maps the name of an included type to the name to use for it in
+	// the including definition, this takes precedence over the Prefix
+	Alias map[string]string `json:",omitempty"`
+}
+
+// UnmarshalJSON allows an include to be specified as just the filename
+func (i *includeDef) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		return json.Unmarshal(b, &i.File)
+	}
+	type plainIncludeDef includeDef
+	return json.Unmarshal(b, (*plainIncludeDef)(i))
+}
+
+// typeName returns the name an included type is known by in the including definition
+func (i *includeDef) typeName(name string) string {
+	if alias, exists := i.Alias[name]; exists {
+		return alias
+	}
+	return i.Prefix + name
+}
+
+// resolveIncludes loads all the included definition files and merges their
+// related types into this definition. chain is the list of files currently
+// being included, its used to detect include cycles.
+func (def *configDef) resolveIncludes(defFile string, chain []string) error {
+	if len(def.Include) > 0 && def.RelatedTypes == nil {
+		def.RelatedTypes = make(map[string]*structInfo)
+	}
+	for i, inc := range def.Include {
+		path := fmt.Sprintf("Include[%d]", i)
+		incFile := inc.File
+		if !filepath.IsAbs(incFile) {
+			incFile = filepath.Join(filepath.Dir(defFile), incFile)
+		}
+		incFile = filepath.Clean(incFile)
+		for _, f := range chain {
+			if f == incFile {
+				return def.errorf(path, "include cycle detected: %s -> %s", strings.Join(chain, " -> "), incFile)
+			}
+		}
+
+		incDef, err := readDefFile(incFile)
+		if err != nil {
+			return errors.Annotatef(err, "unable to include %s from %s", inc.File, defFile)
+		}
+		if err := incDef.resolveIncludes(incFile, append(chain, incFile)); err != nil {
+			return errors.Trace(err)
+		}
+		def.files = append(def.files, incDef.files...)
+		if missing := inc.aliasesNotFound(incDef); len(missing) > 0 {
+			return def.errorf(path, "%s includes %s with aliases for types %s, but they aren't defined in it",
+				defFile, incFile, strings.Join(missing, ","))
+		}
+
+		renamed := make(map[string]string, len(incDef.RelatedTypes))
+		for n := range incDef.RelatedTypes {
+			renamed[n] = inc.typeName(n)
+		}
+		names := make([]string, 0, len(incDef.RelatedTypes))
+		for n := range incDef.RelatedTypes {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			rt := incDef.RelatedTypes[n]
+			rt.renameFieldTypes(renamed)
+			newName := renamed[n]
+			if existing, exists := def.RelatedTypes[newName]; exists {
+				if existing.source == rt.source {
+					// the same type included more than once, e.g. via 2 different includes
+					continue
+				}
+				return def.errorf(path, "related type %s is defined in both %s and %s, use a Prefix or Alias on the include to rename one of them",
+					newName, existing.source, rt.source)
+			}
+			rt.renameComment(n, newName)
+			def.RelatedTypes[newName] = rt
+		}
+	}
+	return nil
+}
+
+// aliasesNotFound returns the names in the Alias map that are not defined in the included definition
+func (i *includeDef) aliasesNotFound(incDef *configDef) []string {
+	var res []string
+	for n := range i.Alias {
+		if _, exists := incDef.RelatedTypes[n]; !exists {
+			res = append(res, n)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// renameFieldTypes updates the types of the fields, and the embedded types
+// that reference a type that has been renamed
+func (s *structInfo) renameFieldTypes(renamed map[string]string) {
+	for idx, e := range s.Embeds {
+		if n, exists := renamed[e]; exists {
+			s.Embeds[idx] = n
+		}
+	}
+	renameTypes(s.Fields, renamed)
+}
+
+// renameTypes updates the types of the fields, including the fields of inline types
+func renameTypes(fields []fieldInfo, renamed map[string]string) {
+	for idx := range fields {
+		f := &fields[idx]
+		renameTypes(f.Fields, renamed)
+		prefix, typ := splitTypePrefix(f.Type)
+		if n, exists := renamed[typ]; exists {
+			f.Type = prefix + n
+		}
+	}
+}
+
+// renameComment updates the type comment if it starts with the original type name,
+// so that the generated code still has the expected comment form
+func (s *structInfo) renameComment(from, to string) {
+	if from == to || !strings.HasPrefix(s.Comment, from) {
+		return
+	}
+	if rest := s.Comment[len(from):]; rest == "" || rest[0] == ' ' {
+		s.Comment = to + rest
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IncludeDefUnmarshal(t *testing.T) {
+	var incs []includeDef
+	err := json.Unmarshal([]byte(`["a.json", {"File":"b.json", "Prefix":"B"}, {"File":"c.json", "Alias":{"X":"Y"}}]`), &incs)
+	require.NoError(t, err)
+	assert.Equal(t, []includeDef{
+		{File: "a.json"},
+		{File: "b.json", Prefix: "B"},
+		{File: "c.json", Alias: map[string]string{"X": "Y"}},
+	}, incs)
+
+	assert.Equal(t, "X", incs[0].typeName("X"))
+	assert.Equal(t, "BX", incs[1].typeName("X"))
+	assert.Equal(t, "Y", incs[2].typeName("X"))
+	assert.Equal(t, "Z", incs[2].typeName("Z"))
+}
+
+func Test_Include(t *testing.T) {
+	td, err := loadConfig("testdata/gen_include.json")
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(td.Structs))
+	for n := range td.Structs {
+		names = append(names, n)
+	}
+	assert.ElementsMatch(t, []string{"Configuration", "HTTPServer", "TLSInfo", "PeerTLSInfo", "AuditLogger"}, names)
+	assert.Equal(t, "[]TLSInfo", td.Structs["HTTPServer"].Fields[3].Type)
+	assert.Equal(t, "testdata/common/tls.json", td.Structs["PeerTLSInfo"].source)
+	assert.Equal(t, "testdata/common/http.json", td.Structs["HTTPServer"].source)
+}
+
+func Test_IncludeErrors(t *testing.T) {
+	_, err := loadConfig("testdata/include_collision.json")
+	require.Error(t, err)
+	assert.Equal(t, "testdata/include_collision.json:3:19: Include[0]: related type TLSInfo is defined in both testdata/include_collision.json and testdata/common/tls.json, use a Prefix or Alias on the include to rename one of them", err.Error())
+
+	_, err = loadConfig("testdata/include_cycle.json")
+	require.Error(t, err)
+	assert.Equal(t, "testdata/include_cycle.json:3:19: Include[0]: include cycle detected: testdata/include_cycle.json -> testdata/include_cycle.json", err.Error())
+
+	def := &configDef{Include: []includeDef{{File: "common/tls.json", Alias: map[string]string{"Bob": "Alice"}}}}
+	err = def.resolveIncludes("testdata/def.json", []string{"testdata/def.json"})
+	require.Error(t, err)
+	assert.Equal(t, "Include[0]: testdata/def.json includes testdata/common/tls.json with aliases for types Bob, but they aren't defined in it", err.Error())
+
+	def = &configDef{Include: []includeDef{{File: "common/missing.json"}}}
+	err = def.resolveIncludes("testdata/def.json", nil)
+	require.Error(t, err)
+	assert.Equal(t, "unable to include common/missing.json from testdata/def.json: unable to open supplied configuration definition file testdata/common/missing.json: open testdata/common/missing.json: no such file or directory", err.Error())
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// this file contains the support for inline struct types, a field can define
+// its fields inline instead of a type, e.g. for a one-off group like Metrics,
+// the inline types are hoisted into the related types before the definition
+// is processed, so they are generated the same as any other related type.
+
+// hoistInlineTypes adds a related type for each field that has inline fields,
+// and sets the type of the field to it, the name of the type is the field's
+// typeName, or the name of the parent type followed by the field name
+func (def *configDef) hoistInlineTypes() error {
+	if def.RelatedTypes == nil {
+		def.RelatedTypes = make(map[string]*structInfo)
+	}
+	names := make([]string, 0, len(def.RelatedTypes))
+	for n := range def.RelatedTypes {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var errs definitionErrors
+	var hoist func(parent *structInfo, parentName string)
+	hoist = func(parent *structInfo, parentName string) {
+		for idx := range parent.Fields {
+			f := &parent.Fields[idx]
+			fp := fmt.Sprintf(".Fields[%d]", idx)
+			if len(f.Fields) == 0 {
+				if f.TypeName != "" {
+					errs = append(errs, parent.errorf(parentName, fp+".typeName",
+						"field %v of %v has a typeName, but no inline fields", f.Name, parentName))
+				}
+				continue
+			}
+			if f.Type != "" {
+				errs = append(errs, parent.errorf(parentName, fp+".type",
+					"field %v of %v has both a type and inline fields, only one of them can be set", f.Name, parentName))
+				continue
+			}
+			name := f.TypeName
+			if name == "" {
+				name = parentName + f.Name
+			}
+			if _, exists := def.RelatedTypes[name]; exists || name == "Configuration" {
+				errs = append(errs, parent.errorf(parentName, fp,
+					"the inline type of field %v of %v is named %v, which is already defined, set a different typeName", f.Name, parentName, name))
+				continue
+			}
+			rt := &structInfo{
+				commentable: commentable{Comment: inlineTypeComment(name, parentName, f)},
+				WithGetter:  parent.WithGetter,
+				WithBuilder: parent.WithBuilder,
+				WithSetter:  parent.WithSetter,
+				Fields:      f.Fields,
+			}
+			rt.setSource(parent.source, parent.index, parent.jsonPath+fp)
+			def.RelatedTypes[name] = rt
+			f.Type, f.Fields, f.TypeName = name, nil, ""
+			hoist(rt, name)
+		}
+	}
+	if def.Configuration != nil {
+		hoist(def.Configuration, "Configuration")
+	}
+	for _, n := range names {
+		hoist(def.RelatedTypes[n], n)
+	}
+	if len(errs) > 0 {
+		errs.sort()
+		return errs
+	}
+	return nil
+}
+
+// inlineTypeComment returns the comment for the inline type of the field, the field
+// comment is reused if it starts with the field name, e.g. "Metrics configures ..."
+func inlineTypeComment(name, parentName string, f *fieldInfo) string {
+	if rest := strings.TrimPrefix(f.Comment, f.Name+" "); rest != f.Comment {
+		return name + " " + rest
+	}
+	return fmt.Sprintf("%s contains the %s settings of %s", name, f.Name, parentName)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InlineTypes(t *testing.T) {
+	def, err := loadConfig("testdata/gen_inline.json")
+	require.NoError(t, err)
+
+	metrics, exists := def.Structs["ConfigurationMetrics"]
+	require.True(t, exists)
+	assert.Equal(t, "ConfigurationMetrics configures the publishing of the metrics", metrics.Comment)
+	assert.True(t, metrics.WithGetter)
+	assert.Equal(t, "ConfigurationMetrics", def.Structs["Configuration"].Fields[1].Type)
+	assert.Equal(t, metrics.GoType, def.Structs["Configuration"].Fields[1].GoType)
+
+	sink, exists := def.Structs["MetricsSink"]
+	require.True(t, exists)
+	assert.Equal(t, "MetricsSink is where the metrics are sent to", sink.Comment)
+	assert.Equal(t, "MetricsSink", metrics.Fields[2].Type)
+	assert.Len(t, sink.GoType.ExampleValues, 6)
+
+	limits, exists := def.Structs["ServerLimits"]
+	require.True(t, exists)
+	assert.Equal(t, "ServerLimits contains the Limits settings of Server", limits.Comment)
+	assert.True(t, limits.WithBuilder)
+	assert.Equal(t, `{"BindAddr":":8080","Limits":{"Timeout":"30s"}}`, def.Structs["Server"].DefaultsJSON())
+}
+
+func Test_InvalidInlineTypes(t *testing.T) {
+	_, err := loadConfig("testdata/invalid_inline_test.json")
+	require.Error(t, err)
+	errs, ok := errors.Cause(err).(definitionErrors)
+	require.True(t, ok, "%T", errors.Cause(err))
+	require.Len(t, errs, 3)
+	assert.Equal(t, "testdata/invalid_inline_test.json:5:41: Configuration.Fields[0].type: field Both of Configuration has both a type and inline fields, only one of them can be set", errs[0].Error())
+	assert.Equal(t, "testdata/invalid_inline_test.json:6:46: Configuration.Fields[1].typeName: field Named of Configuration has a typeName, but no inline fields", errs[1].Error())
+	assert.Equal(t, "testdata/invalid_inline_test.json:7:13: Configuration.Fields[2]: the inline type of field Server of Configuration is named ConfigurationServer, which is already defined, set a different typeName", errs[2].Error())
+}
@@ -0,0 +1,441 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/juju/errors"
+)
+
+// this file contains the lint command, this checks definition files for
+// problems that would cause the generation to fail, or the generated code to
+// not compile or not pass golint, and reports them with their position in the file.
+
+const (
+	sevError   = "error"
+	sevWarning = "warning"
+)
+
+// diagnostic is a single problem found in a definition file
+type diagnostic struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	// Code identifies the check that found the problem, e.g. unknown-type
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Suggestion if set, is the suggested replacement for the value at the position
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+func (d diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s [%s]", d.File, d.Line, d.Column, d.Severity, d.Message, d.Code)
+}
+
+// reservedTypeNames are the names of the types that are generated in every package
+var reservedTypeNames = map[string]bool{
+	"Configurations": true,
+	"Duration":       true,
+	"HostSelection":  true,
+	"Selector":       true,
+	"Change":         true,
+	"Holder":         true,
+	"Option":         true,
+}
+
+// runLint implements the lint command
+// usage configen lint [-json] <config_def.json> ...
+func runLint(args []string) error {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	asJSON := flags.Bool("json", false, "Write the diagnostics as a json array")
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		return errors.New("usage: configen lint [-json] <config_def.json> ...")
+	}
+	var all []diagnostic
+	for _, fn := range flags.Args() {
+		diags, err := lintDefinition(fn)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		all = append(all, diags...)
+	}
+	if err := writeDiagnostics(os.Stdout, all, *asJSON); err != nil {
+		return errors.Trace(err)
+	}
+	errCount := 0
+	for _, d := range all {
+		if d.Severity == sevError {
+			errCount++
+		}
+	}
+	if errCount > 0 {
+		return errors.Errorf("%d errors found", errCount)
+	}
+	return nil
+}
+
+// writeDiagnostics writes the diagnostics one per line, or as a json array
+func writeDiagnostics(w io.Writer, diags []diagnostic, asJSON bool) error {
+	if asJSON {
+		if diags == nil {
+			diags = []diagnostic{}
+		}
+		b, err := json.MarshalIndent(diags, "", "    ")
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return errors.Trace(err)
+	}
+	for _, d := range diags {
+		if _, err := fmt.Fprintln(w, d.String()); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// linter checks a single definition file
+type linter struct {
+	file  string
+	index *jsonIndex
+	def   *configDef
+	diags []diagnostic
+}
+
+// lintDefinition returns the problems found in the definition file, the
+// returned error is only set if the file can't be read
+func lintDefinition(defFile string) ([]diagnostic, error) {
+	data, err := ioutil.ReadFile(defFile)
+	if err != nil {
+		return nil, errors.Errorf("unable to read configuration definition file %v: %v", defFile, err)
+	}
+	l := &linter{file: defFile}
+	l.index, err = indexJSON(data)
+	if err != nil {
+		l.index = &jsonIndex{data: data, offsets: map[string]int{}}
+		l.reportOffset(jsonErrorOffset(err, len(data)), sevError, "json-syntax", fmt.Sprintf("invalid json: %v", err), "")
+		return l.diags, nil
+	}
+	var def configDef
+	if err = json.Unmarshal(data, &def); err != nil {
+		l.reportOffset(jsonErrorOffset(err, len(data)), sevError, "json-type", fmt.Sprintf("invalid definition: %v", err), "")
+		return l.diags, nil
+	}
+	if def.Configuration != nil {
+		def.Configuration.setSource(defFile, l.index, "Configuration")
+	}
+	for n, rt := range def.RelatedTypes {
+		rt.setSource(defFile, l.index, "RelatedTypes."+n)
+	}
+	l.def = &def
+	if err = def.resolveIncludes(defFile, []string{filepath.Clean(defFile)}); err != nil {
+		l.report("include", sevError, "include", err.Error(), "")
+	}
+	if errs, ok := def.hoistInlineTypes().(definitionErrors); ok {
+		for _, e := range errs {
+			l.report(strings.ToLower(e.Path), sevError, "inline-type", e.Msg, "")
+		}
+	}
+	l.lint()
+	sort.SliceStable(l.diags, func(a, b int) bool {
+		if l.diags[a].Line != l.diags[b].Line {
+			return l.diags[a].Line < l.diags[b].Line
+		}
+		return l.diags[a].Column < l.diags[b].Column
+	})
+	return l.diags, nil
+}
+
+// jsonErrorOffset returns the offset in the document of a json decoding error
+func jsonErrorOffset(err error, size int) int {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		return int(e.Offset)
+	case *json.UnmarshalTypeError:
+		return int(e.Offset)
+	}
+	return size
+}
+
+// report adds a diagnostic at the position of the value with the json path, if the
+// path isn't in the document, the position of the closest parent is used
+func (l *linter) report(path, severity, code, msg, suggestion string) {
+	l.reportOffset(l.index.offset(path), severity, code, msg, suggestion)
+}
+
+func (l *linter) reportOffset(offset int, severity, code, msg, suggestion string) {
+	line, col := l.index.position(offset)
+	l.diags = append(l.diags, diagnostic{
+		File:       l.file,
+		Line:       line,
+		Column:     col,
+		Severity:   severity,
+		Code:       code,
+		Message:    msg,
+		Suggestion: suggestion,
+	})
+}
+
+// lint runs all the checks on the structs defined in the file
+func (l *linter) lint() {
+	for _, dup := range l.index.duplicates {
+		if strings.HasPrefix(dup.path, "relatedtypes.") && strings.Count(dup.path, ".") == 1 {
+			l.report(dup.path, sevError, "duplicate-type", fmt.Sprintf("related type %s is defined more than once, only the last definition is used", dup.key), "")
+		} else {
+			l.report(dup.path, sevWarning, "duplicate-key", fmt.Sprintf("%s is defined more than once, only the last value is used", dup.key), "")
+		}
+	}
+	if !validNaming(l.def.Naming) {
+		l.report("naming", sevError, "naming", fmt.Sprintf("naming policy %q isn't valid, it should be one of %s",
+			l.def.Naming, strings.Join(namingPolicies, ", ")), closestName(l.def.Naming, namingPolicies))
+	}
+	if l.def.Configuration != nil {
+		l.lintStruct("Configuration", "configuration", l.def.Configuration)
+	}
+	names := make([]string, 0, len(l.def.RelatedTypes))
+	for n, rt := range l.def.RelatedTypes {
+		if rt.source == l.file {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	generated := l.generatedTypeNames()
+	for _, n := range names {
+		// inline types are reported at the field they are defined in
+		path := strings.ToLower(l.def.RelatedTypes[n].jsonPath)
+		l.lintName(path, "related type", n)
+		if reservedTypeNames[n] || n == "Configuration" {
+			l.report(path, sevError, "reserved-name", fmt.Sprintf("related type %s has the same name as a generated type", n), "")
+		} else if from, exists := generated[n]; exists {
+			l.report(path, sevError, "reserved-name", fmt.Sprintf("related type %s has the same name as the type generated for %s", n, from), "")
+		}
+		l.lintStruct(n, path, l.def.RelatedTypes[n])
+	}
+	if l.def.Configuration != nil {
+		used := l.usedTypes()
+		for _, n := range names {
+			if !used[n] {
+				l.report(strings.ToLower(l.def.RelatedTypes[n].jsonPath), sevWarning, "unused-type", fmt.Sprintf("related type %s isn't used by the Configuration", n), "")
+			}
+		}
+	}
+}
+
+// generatedTypeNames returns the names of the types generated for the WithGetter,
+// WithBuilder and WithSetter options, mapped to the type they are generated for
+func (l *linter) generatedTypeNames() map[string]string {
+	res := map[string]string{}
+	add := func(n string, s *structInfo) {
+		if s.WithGetter {
+			res[n+"Config"] = n
+			res["Mock"+n+"Config"] = n
+		}
+		if s.WithBuilder {
+			res[n+"Builder"] = n
+		}
+		if s.WithSetter {
+			res[n+"Setter"] = n
+		}
+	}
+	if l.def.Configuration != nil {
+		add("Configuration", l.def.Configuration)
+	}
+	for n, rt := range l.def.RelatedTypes {
+		add(n, rt)
+	}
+	return res
+}
+
+// usedTypes returns the names of the related types that are reachable from the Configuration
+func (l *linter) usedTypes() map[string]bool {
+	used := map[string]bool{}
+	var visit func(s *structInfo)
+	visit = func(s *structInfo) {
+		names := append([]string{}, s.Embeds...)
+		for _, f := range s.Fields {
+			_, elem := splitTypePrefix(f.Type)
+			names = append(names, elem)
+		}
+		for _, n := range names {
+			if rt, exists := l.def.RelatedTypes[n]; exists && !used[n] {
+				used[n] = true
+				visit(rt)
+			}
+		}
+	}
+	visit(l.def.Configuration)
+	return used
+}
+
+// lintName reports names that aren't valid exported go identifiers
+func (l *linter) lintName(path, kind, name string) {
+	switch {
+	case name == "":
+		l.report(path, sevError, "missing-name", fmt.Sprintf("%s has no name", kind), "")
+	case token.IsKeyword(name):
+		l.report(path, sevError, "reserved-name", fmt.Sprintf("%s %s is a go keyword", kind, name), exportedName(name))
+	case !token.IsIdentifier(name):
+		l.report(path, sevError, "invalid-name", fmt.Sprintf("%s %q isn't a valid go identifier", kind, name), "")
+	case !token.IsExported(name):
+		l.report(path, sevError, "unexported-name", fmt.Sprintf("%s %s isn't exported, it should start with an upper case letter", kind, name), exportedName(name))
+	}
+}
+
+// lintComment reports comments that golint would reject in the generated code
+func (l *linter) lintComment(path, kind, name, comment string, required bool) {
+	c := strings.TrimSpace(comment)
+	if c == "" {
+		if required {
+			l.report(path, sevWarning, "missing-comment", fmt.Sprintf("%s %s has no comment", kind, name), "")
+		}
+		return
+	}
+	ok := strings.HasPrefix(c, name+" ")
+	for _, a := range []string{"A ", "An ", "The "} {
+		ok = ok || strings.HasPrefix(c, a+name+" ")
+	}
+	if !ok {
+		l.report(path, sevWarning, "comment-form", fmt.Sprintf("the comment of %s %s should start with %q", kind, name, name+" "), "")
+	}
+}
+
+// lintStruct checks the struct and its fields
+func (l *linter) lintStruct(name, path string, s *structInfo) {
+	l.lintComment(path+".comment", "type", name, s.Comment, true)
+	for idx, e := range s.Embeds {
+		l.lintEmbed(fmt.Sprintf("%s.embeds[%d]", path, idx), e)
+	}
+	seen := map[string]int{}
+	for idx, f := range s.Fields {
+		fp := fmt.Sprintf("%s.fields[%d]", path, idx)
+		l.lintName(fp+".name", "field", f.Name)
+		if first, exists := seen[f.Name]; exists && f.Name != "" {
+			line, _ := l.index.position(l.index.offset(fmt.Sprintf("%s.fields[%d].name", path, first)))
+			l.report(fp+".name", sevError, "duplicate-field", fmt.Sprintf("field %s is defined more than once in %s, it's also defined on line %d", f.Name, name, line), "")
+		} else {
+			seen[f.Name] = idx
+		}
+		if msg := s.fieldNameClash(name, &s.Fields[idx]); msg != "" {
+			l.report(fp+".name", sevError, "reserved-name", msg, "")
+		}
+		if f.Name != "" {
+			l.lintComment(fp+".comment", "field", f.Name, f.Comment, s.WithGetter)
+		}
+		if len(f.Fields) == 0 {
+			// inline types that couldn't be hoisted have already been reported
+			l.lintType(fp+".type", f)
+		}
+	}
+}
+
+// lintEmbed reports embedded types that aren't related types, with the closest related type as the suggestion
+func (l *linter) lintEmbed(path, name string) {
+	if _, exists := l.def.RelatedTypes[name]; exists {
+		return
+	}
+	known := make([]string, 0, len(l.def.RelatedTypes))
+	for n := range l.def.RelatedTypes {
+		known = append(known, n)
+	}
+	sort.Strings(known)
+	msg := fmt.Sprintf("embedded type %s isn't a related type", name)
+	s := closestName(name, known)
+	if s != "" {
+		msg += fmt.Sprintf(", did you mean %s?", s)
+	}
+	l.report(path, sevError, "unknown-type", msg, s)
+}
+
+// lintType reports unknown field types, with the closest valid type as the suggestion
+func (l *linter) lintType(path string, f fieldInfo) {
+	if f.Type == "" {
+		l.report(path, sevError, "missing-type", fmt.Sprintf("field %s has no type", f.Name), "")
+		return
+	}
+	known := l.def.typeNames()
+	for n := range l.def.RelatedTypes {
+		known = append(known, n)
+		for _, p := range relatedTypePrefixes {
+			known = append(known, p+n)
+		}
+	}
+	for _, k := range known {
+		if k == f.Type {
+			return
+		}
+	}
+	msg := fmt.Sprintf("field %s has type %s which isn't valid", f.Name, f.Type)
+	s := closestName(f.Type, known)
+	if s != "" {
+		msg += fmt.Sprintf(", did you mean %s?", s)
+	}
+	l.report(path, sevError, "unknown-type", msg, s)
+}
+
+// closestName returns the candidate that is the closest match for name, or an
+// empty string if none of them are close enough to be a likely misspelling
+func closestName(name string, candidates []string) string {
+	best, bestDist := "", -1
+	lname := strings.ToLower(name)
+	for _, c := range candidates {
+		d := editDistance(lname, strings.ToLower(c))
+		if bestDist < 0 || d < bestDist || (d == bestDist && c < best) {
+			best, bestDist = c, d
+		}
+	}
+	if bestDist < 0 || (bestDist > 2 && bestDist > len(name)/3) {
+		return ""
+	}
+	return best
+}
+
+// editDistance returns the levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// exportedName returns name with its first letter in upper case
+func exportedName(name string) string {
+	for i, r := range name {
+		return string(unicode.ToUpper(r)) + name[i+len(string(r)):]
+	}
+	return name
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LintDefinition(t *testing.T) {
+	diags, err := lintDefinition("testdata/lint_test.json")
+	require.NoError(t, err)
+
+	type found struct {
+		Line       int
+		Code       string
+		Suggestion string
+	}
+	act := make([]found, len(diags))
+	for i, d := range diags {
+		act[i] = found{d.Line, d.Code, d.Suggestion}
+	}
+	assert.Equal(t, []found{
+		{6, "unknown-type", "HTTPServer"},
+		{7, "unexported-name", "Bob"},
+		{7, "unknown-type", ""},
+		{8, "unknown-type", "Duration"},
+		{8, "comment-form", ""},
+		{12, "unused-type", ""},
+		{16, "unexported-name", "B"},
+		{17, "unexported-name", "B"},
+		{17, "duplicate-field", ""},
+		{18, "reserved-name", ""},
+		{19, "reserved-name", "Type"},
+		{20, "missing-comment", ""},
+		{20, "unknown-type", "[]string"},
+		{23, "unused-type", ""},
+		{29, "reserved-name", ""},
+		{29, "missing-comment", ""},
+		{29, "unused-type", ""},
+	}, act)
+
+	assert.Equal(t, "testdata/lint_test.json:6:37: error: field HTTP has type HTTPServr which isn't valid, did you mean HTTPServer? [unknown-type]", diags[0].String())
+	assert.Equal(t, "field b is defined more than once in HTTPServer, it's also defined on line 16", diags[8].Message)
+
+	for _, clean := range []string{"testdata/gen_def.json", "testdata/gen_slices.json", "testdata/gen_include.json"} {
+		diags, err = lintDefinition(clean)
+		require.NoError(t, err)
+		for _, d := range diags {
+			assert.NotEqual(t, sevError, d.Severity, "%s", d)
+		}
+	}
+}
+
+func Test_LintInlineTypes(t *testing.T) {
+	diags, err := lintDefinition("testdata/gen_inline.json")
+	require.NoError(t, err)
+	assert.Empty(t, diags)
+
+	diags, err = lintDefinition("testdata/invalid_inline_test.json")
+	require.NoError(t, err)
+	codes := make([]string, len(diags))
+	for i := range diags {
+		codes[i] = diags[i].Code
+	}
+	assert.Equal(t, []string{"inline-type", "inline-type", "inline-type", "unused-type", "unknown-type"}, codes)
+	assert.Equal(t, "testdata/invalid_inline_test.json:7:13: error: the inline type of field Server of Configuration is named ConfigurationServer, which is already defined, set a different typeName [inline-type]", diags[2].String())
+}
+
+func Test_LintEmbeds(t *testing.T) {
+	diags, err := lintDefinition("testdata/gen_embed.json")
+	require.NoError(t, err)
+	assert.Empty(t, diags)
+
+	diags, err = lintDefinition("testdata/invalid_embed_test.json")
+	require.NoError(t, err)
+	require.Len(t, diags, 4)
+	assert.Equal(t, "testdata/invalid_embed_test.json:4:22: error: embedded type TLSInfoo isn't a related type, did you mean TLSInfo? [unknown-type]", diags[0].String())
+	assert.Equal(t, "TLSInfo", diags[0].Suggestion)
+	assert.Equal(t, "unused-type", diags[3].Code)
+}
+
+func Test_LintRecursive(t *testing.T) {
+	diags, err := lintDefinition("testdata/gen_recursive.json")
+	require.NoError(t, err)
+	for _, d := range diags {
+		assert.NotEqual(t, sevError, d.Severity, "%s", d)
+		assert.NotEqual(t, "unused-type", d.Code, "%s", d)
+	}
+
+	dir, err := ioutil.TempDir("", "lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fn := filepath.Join(dir, "recursive.json")
+	require.NoError(t, ioutil.WriteFile(fn, []byte(`{
+  "Configuration" : { "Comment" : "Configuration is the config", "Fields" : [
+    { "name" : "Nodes", "type" : "map[string]Node", "comment" : "Nodes by name" },
+    { "name" : "Root", "type" : "*Nod", "comment" : "Root node" },
+    { "name" : "Leaf", "type" : "*Leaf", "comment" : "Leaf value" }
+  ] },
+  "RelatedTypes" : {
+    "Node" : { "Comment" : "Node is a node", "Fields" : [
+      { "name" : "Next", "type" : "*Node", "comment" : "Next node" },
+      { "name" : "Children", "type" : "map[string]Nod", "comment" : "Children by name" }
+    ] },
+    "Leaf" : { "Comment" : "Leaf is only used by a pointer", "Fields" : [ { "name" : "A", "type" : "string", "comment" : "A value" } ] },
+    "Other" : { "Comment" : "Other isn't used", "Fields" : [ { "name" : "Leaves", "type" : "map[string]Leaf", "comment" : "Leaves by name" } ] }
+  }
+}`), 0664))
+	diags, err = lintDefinition(fn)
+	require.NoError(t, err)
+	act := make([]string, len(diags))
+	for i, d := range diags {
+		act[i] = d.Code + " " + d.Suggestion
+	}
+	assert.Equal(t, []string{"unknown-type *Node", "unknown-type map[string]Node", "unused-type "}, act)
+}
+
+func Test_LintNaming(t *testing.T) {
+	diags, err := lintDefinition("testdata/gen_naming.json")
+	require.NoError(t, err)
+	assert.Empty(t, diags)
+
+	dir, err := ioutil.TempDir("", "lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fn := filepath.Join(dir, "naming.json")
+	require.NoError(t, ioutil.WriteFile(fn, []byte(`{
+  "Naming" : "snakecase",
+  "Configuration" : { "Comment" : "Configuration is the config", "Fields" : [ { "name" : "A", "type" : "string" } ] }
+}`), 0664))
+	diags, err = lintDefinition(fn)
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "naming", diags[0].Code)
+	assert.Equal(t, 2, diags[0].Line)
+	assert.Equal(t, "snake_case", diags[0].Suggestion)
+}
+
+func Test_LintInvalidJSON(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fn := filepath.Join(dir, "bad.json")
+	require.NoError(t, ioutil.WriteFile(fn, []byte("{\n  \"Configuration\" : {\n    \"Fields\" : [ }\n}\n"), 0664))
+	diags, err := lintDefinition(fn)
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "json-syntax", diags[0].Code)
+	assert.Equal(t, 3, diags[0].Line)
+
+	require.NoError(t, ioutil.WriteFile(fn, []byte("{\n  \"Configuration\" : {\n    \"Fields\" : [ { \"name\" : 42 } ]\n  },\n  \"RelatedTypes\" : {}\n}\n"), 0664))
+	diags, err = lintDefinition(fn)
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "json-type", diags[0].Code)
+	assert.Equal(t, 3, diags[0].Line)
+
+	require.NoError(t, ioutil.WriteFile(fn, []byte(`{
+  "Configuration" : { "Comment" : "Configuration is the config", "Fields" : [ { "name" : "A", "type" : "B", "comment" : "A is a" } ] },
+  "RelatedTypes" : {
+    "B" : { "Comment" : "B is b", "Fields" : [] },
+    "B" : { "Comment" : "B is b", "Fields" : [] }
+  }
+}`), 0664))
+	diags, err = lintDefinition(fn)
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "duplicate-type", diags[0].Code)
+	assert.Equal(t, 5, diags[0].Line)
+
+	_, err = lintDefinition(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func Test_WriteDiagnostics(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, writeDiagnostics(&b, nil, true))
+	assert.Equal(t, "[]\n", b.String())
+
+	d := diagnostic{File: "a.json", Line: 2, Column: 3, Severity: sevError, Code: "unknown-type", Message: "bad", Suggestion: "string"}
+	b.Reset()
+	require.NoError(t, writeDiagnostics(&b, []diagnostic{d}, true))
+	var decoded []map[string]interface{}
+	require.NoError(t, json.Unmarshal(b.Bytes(), &decoded))
+	assert.Equal(t, []map[string]interface{}{{
+		"file": "a.json", "line": 2.0, "column": 3.0, "severity": "error", "code": "unknown-type", "message": "bad", "suggestion": "string",
+	}}, decoded)
+
+	b.Reset()
+	require.NoError(t, writeDiagnostics(&b, []diagnostic{d}, false))
+	assert.Equal(t, "a.json:2:3: error: bad [unknown-type]\n", b.String())
+}
+
+func Test_ClosestName(t *testing.T) {
+	names := []string{"string", "[]string", "Duration", "HTTPServer"}
+	assert.Equal(t, "string", closestName("Stirng", names))
+	assert.Equal(t, "Duration", closestName("duration", names))
+	assert.Equal(t, "[]string", closestName("[]strings", names))
+	assert.Equal(t, "", closestName("Alice", names))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+	assert.Equal(t, "Bob", exportedName("bob"))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
@@ -12,13 +13,14 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/go-phorce/configen/version"
 	"github.com/juju/errors"
 )
 
-//go:generate resources -var=assets -output templates.go config.go.template config_test.go.template
+//go:generate resources -var=assets -output templates.go config.go.template config_test.go.template cli.go.template mock_config.go.template mock_config_test.go.template
 
 type resources interface {
 	http.FileSystem
@@ -28,12 +30,35 @@ type resources interface {
 // Assets are generated by resources tools
 var assets resources
 
+// commands contains the additional sub commands, e.g. configen import -pkg <package>
+var commands = map[string]func(args []string) error{
+	"import":  runImport,
+	"lint":    runLint,
+	"migrate": runMigrate,
+}
+
 // usage config-gen -c <config_def.json> -d <dest path>
 // see the configDef type for details of what the config_def.json file should contain
 func main() {
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			if err := cmd(os.Args[2:]); err != nil {
+				log.Println(err.Error())
+				os.Exit(-1)
+			}
+			return
+		}
+	}
+
 	ver := flag.Bool("v", false, "Print version")
 	def := flag.String("c", "", "Filename of the configuration definition file")
 	dest := flag.String("d", ".", "Directory to write generated files(s) to")
+	sample := flag.Bool("sample", false, "Write sample configuration files (json and yaml) to the destination directory")
+	manifest := flag.String("m", "", "Filename of a manifest file, that lists the definition files and output directories to generate")
+	force := flag.Bool("force", false, "With -m, generate all the targets even if they are unchanged")
+	mocks := flag.Bool("mocks", false, "Write mock_config.go, with fake implementations of the getter interfaces, to the destination directory")
+	cli := flag.Bool("cli", false, "Write the config inspection command to cmd/<package>-config in the module root")
+	importPath := flag.String("import", "", "With -cli, the import path of the generated package, defaults to the path derived from go.mod")
 	flag.Parse()
 
 	if *ver {
@@ -41,6 +66,14 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *manifest != "" {
+		if err := runManifest(*manifest, *force); err != nil {
+			log.Println(err.Error())
+			os.Exit(-1)
+		}
+		return
+	}
+
 	if *def == "" {
 		log.Fatal("must specify the name of the configuration definition file")
 	}
@@ -53,10 +86,28 @@ func main() {
 		log.Println(err.Error())
 		os.Exit(-1)
 	}
+	if *sample {
+		if err = generateSample(*def, *dest); err != nil {
+			log.Println(err.Error())
+			os.Exit(-1)
+		}
+	}
+	if *mocks {
+		if err = generateMocks(*def, *dest); err != nil {
+			log.Println(err.Error())
+			os.Exit(-1)
+		}
+	}
+	if *cli {
+		if _, err = generateCLI(*def, *dest, *importPath); err != nil {
+			log.Println(err.Error())
+			os.Exit(-1)
+		}
+	}
 }
 
 type commentable struct {
-	Comment string
+	Comment string `json:"comment,omitempty"`
 }
 
 func (c commentable) PrefixedComment() string {
@@ -73,10 +124,46 @@ func (c commentable) PrefixedComment() string {
 // fieldInfo is metadata about a single field declared in the config definition
 type fieldInfo struct {
 	commentable
-	Name string
-	Type string
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Default is an optional json value for the field, it's used when generating the sample config files
+	Default json.RawMessage `json:"default,omitempty"`
+	// Secret if set, the value of the field is masked in the results of the generated Diff method
+	Secret bool `json:"secret,omitempty"`
+	// Fields if set, defines the fields of an inline struct type for the field, instead of the Type
+	Fields []fieldInfo `json:"fields,omitempty"`
+	// TypeName is the name of the inline struct type, it defaults to the name of the parent type followed by the field name
+	TypeName string `json:"typeName,omitempty"`
+	// JSON is the key of the field in the config file, it defaults to the field name with the Naming policy of the definition
+	JSON string `json:"json,omitempty"`
+	// OmitEmpty if set, the omitempty option is added to the json, yaml and toml tags of the field
+	OmitEmpty bool `json:"omitempty,omitempty"`
+	// Tags contains the values of the extra struct tags for the field, e.g. "env" : "LISTEN_ADDR", they
+	// default to the key of the field, or the upper case snake_case field name for the env tag
+	Tags map[string]string `json:"tags,omitempty"`
+	// Deprecated if set, marks the field as deprecated, e.g. "use BindAddr instead", it's included in the
+	// Deprecated comments of the field and its getter, and in the warnings when the field is used in a config file
+	Deprecated string `json:"deprecated,omitempty"`
+	// Aliases are the previous keys of the field, e.g. after it was renamed, they are still accepted in the config files
+	Aliases []string `json:"aliases,omitempty"`
+	// Overridable if set to false, the field can only be set in the Defaults, it's the same for all the hosts
+	Overridable *bool `json:"overridable,omitempty"`
 	// GoType will be populated by code, not from the json [this is exported so the template can access it]
-	GoType *typeInfo
+	GoType *typeInfo `json:"-"`
+	// interfaceGetter is set when the getter of the field returns the <Type>Config
+	// interface [or a slice of them] rather than the related type
+	interfaceGetter bool
+	// embedded is set for the fields created for the Embeds of the struct
+	embedded bool
+	// jsonKey is the key of the field in the config file, and tags are its struct tags
+	jsonKey string
+	tags    []string
+}
+
+// IsInterfaceSlice returns true if the getter for a slice of a related type returns
+// a slice of the <Type>Config interface
+func (f *fieldInfo) IsInterfaceSlice() bool {
+	return f.interfaceGetter && strings.HasPrefix(f.Type, "[]")
 }
 
 // OverrideImpl pipe returns overrideFrom implementation
@@ -102,13 +189,139 @@ func (f *fieldInfo) IsDuration() bool {
 	return f.GoType.overrideStyle == osCompareZero && f.Type == "Duration"
 }
 
+// CloneImpl pipe returns the statement that deep copies the field from c into res,
+// or an empty string if the field is copied by value
+func (f *fieldInfo) CloneImpl() string {
+	if f.GoType.overrideStyle == osStruct {
+		return fmt.Sprintf("res.%s = *c.%s.Clone()", f.Name, f.Name)
+	}
+	if cf := f.GoType.CloneFunc(); cf != "" {
+		return fmt.Sprintf("res.%s = %s(c.%s)", f.Name, cf, f.Name)
+	}
+	return ""
+}
+
 // structInfo is metadata about a collection of fields that are mapped to a single go struct
 type structInfo struct {
 	commentable
-	WithGetter bool
-	Fields     []fieldInfo
+	WithGetter bool `json:",omitempty"`
+	// WithBuilder if set, a <Name>Builder is generated, that builds the struct with fluent setters
+	WithBuilder bool `json:",omitempty"`
+	// WithSetter if set, a Set<Field> method is generated for each field, and a <Name>Setter interface
+	WithSetter bool `json:",omitempty"`
+	// Embeds is the list of related types that are embedded in the struct, their fields are promoted
+	Embeds []string `json:",omitempty"`
+	Fields []fieldInfo
 	// GoType will be created & populated via the config post processing, not from the json [this is exported so the template can access it]
-	GoType *typeInfo
+	GoType *typeInfo `json:"-"`
+	// source is the definition file that the type was loaded from
+	source string
+	// index and jsonPath locate the definition of the type in the source file
+	index    *jsonIndex
+	jsonPath string
+	// hasDeprecations is set if the json of the struct can contain deprecated fields or aliases,
+	// including those of the nested structs
+	hasDeprecations bool
+	// hasRenamed is set if the json of the struct can contain aliases, including those of the nested structs
+	hasRenamed bool
+	// hasFixed is set if the struct has fields that can't be overridden, including those of the nested structs
+	hasFixed bool
+}
+
+// setSource records where the type is defined
+func (s *structInfo) setSource(file string, index *jsonIndex, jsonPath string) {
+	s.source, s.index, s.jsonPath = file, index, jsonPath
+}
+
+// errorf returns an error for the value with the json path relative to the type definition
+func (s *structInfo) errorf(name, path, format string, args ...interface{}) *definitionError {
+	return newDefinitionError(s.source, s.index, name, s.jsonPath+path, format, args...)
+}
+
+// markStructs sets the flag of each struct that has a field for which has returns true,
+// including the fields of its nested structs
+func markStructs(structs map[string]*structInfo, flag func(*structInfo) *bool, has func(*fieldInfo) bool) {
+	for _, s := range structs {
+		for _, f := range s.GetterFields() {
+			*flag(s) = *flag(s) || has(f)
+		}
+	}
+	// a struct is also marked if any of its nested structs is, this is repeated until
+	// nothing changes, as the structs can be recursive
+	for changed := true; changed; {
+		changed = false
+		for _, s := range structs {
+			for _, f := range s.GetterFields() {
+				if nested := f.GoType.structDef; !*flag(s) && nested != nil && *flag(nested) {
+					*flag(s), changed = true, true
+				}
+			}
+		}
+	}
+}
+
+// defaultComment sets the comment of the field to its name followed by text, if it
+// doesn't have one, as golint requires the comment of the getter to start with its name
+func (f *fieldInfo) defaultComment(text string) {
+	if strings.TrimSpace(f.Comment) == "" {
+		f.Comment = f.Name + " " + text
+	}
+}
+
+// reservedFieldNames are the names of the methods that are generated for every struct
+var reservedFieldNames = map[string]bool{
+	"Clone":   true,
+	"Equal":   true,
+	"Diff":    true,
+	"GetPath": true,
+	"SetPath": true,
+	"Walk":    true,
+}
+
+// fieldNameClash returns the reason that the name of the field clashes with a
+// method that's generated for the struct, or an empty string if it doesn't
+func (s *structInfo) fieldNameClash(name string, f *fieldInfo) string {
+	switch {
+	case reservedFieldNames[f.Name]:
+		return fmt.Sprintf("field %s of %s has the same name as the generated %s method", f.Name, name, f.Name)
+	case f.Name == "Build" && s.WithBuilder:
+		return fmt.Sprintf("field Build of %s clashes with the Build method of its builder, it can't be WithBuilder", name)
+	case f.Name == "Path" && s.WithSetter:
+		return fmt.Sprintf("field Path of %s clashes with the generated SetPath method, it can't be WithSetter", name)
+	case f.Name == "Path" && s.WithGetter:
+		return fmt.Sprintf("field Path of %s clashes with the generated GetPath method, it can't be WithGetter", name)
+	}
+	return ""
+}
+
+// validateFieldNames returns errors for the fields of the struct with a name that
+// clashes with a method that's generated for the struct
+func (s *structInfo) validateFieldNames(name string) definitionErrors {
+	var errs definitionErrors
+	for idx := range s.Fields {
+		if msg := s.fieldNameClash(name, &s.Fields[idx]); msg != "" {
+			errs = append(errs, s.errorf(name, s.fieldPath(idx, "name"), "%s", msg))
+		}
+	}
+	return errs
+}
+
+// getter returns the name and result type of the getter method for the field
+func (f *fieldInfo) getter() (string, string) {
+	mn := "Get" + f.Name
+	switch {
+	case f.GoType.overrideStyle == osStruct && f.interfaceGetter:
+		return mn + "Cfg", f.Type + "Config"
+	case f.GoType.overrideStyle == osStruct:
+		return mn + "Cfg", "*" + f.Type
+	case f.IsInterfaceSlice():
+		return mn, f.Type + "Config"
+	case f.IsBoolPtr():
+		return mn, "bool"
+	case f.IsDuration():
+		return mn, "time.Duration"
+	}
+	return mn, f.GoType.Name
 }
 
 // GettersImpl pipe returns Getters implementation
@@ -119,58 +332,37 @@ func (s *structInfo) GettersImpl() string {
 	list = append(list, strings.Replace(s.PrefixedComment(), s.GoType.Name, intName, -1))
 	list = append(list, fmt.Sprintf("type %s interface {", intName))
 	for _, f := range s.Fields {
-		var ft string
-		mn := "Get" + f.Name
-		if f.GoType.overrideStyle == osStruct {
-			mn += "Cfg"
-			ft = fmt.Sprintf("%s() *%s", mn, f.Type)
-		} else if f.IsBoolPtr() {
-			ft = fmt.Sprintf("%s() bool", mn)
-		} else if f.IsDuration() {
-			ft = fmt.Sprintf("%s() time.Duration", mn)
-		} else {
-			ft = fmt.Sprintf("%s() %s", mn, f.Type)
+		if f.embedded {
+			// the getters of the embedded type are promoted
+			list = append(list, f.GoType.Name+"Config")
+			continue
 		}
-		list = append(list, strings.Replace(f.PrefixedComment(), f.GoType.Name, mn, -1))
-		list = append(list, ft)
+		mn, rt := f.getter()
+		list = append(list, strings.Replace(f.PrefixedComment(), f.GoType.Name, mn, -1)+f.FixedComment()+f.DeprecatedComment())
+		list = append(list, fmt.Sprintf("%s() %s", mn, rt))
 	}
 	list = append(list, "}")
 
 	for _, f := range s.Fields {
-		var fs string
-		mn := "Get" + f.Name
-		if f.GoType.overrideStyle == osStruct {
-			mn += "Cfg"
-			fs = fmt.Sprintf("func (c *%s) %s() *%s {\n\treturn &c.%s\n}",
-				s.GoType.Name,
-				mn,
-				f.Type,
-				f.Name,
-			)
-			strings.Replace(f.PrefixedComment(), f.Name, mn, -1)
-		} else if f.IsBoolPtr() {
-			fs = fmt.Sprintf("func (c *%s) %s() bool {\n\treturn c.%s != nil && *c.%s\n}",
-				s.GoType.Name,
-				mn,
-				f.Name,
-				f.Name,
-			)
-		} else if f.IsDuration() {
-			fs = fmt.Sprintf("func (c *%s) %s() time.Duration {\n\treturn c.%s.TimeDuration()\n}",
-				s.GoType.Name,
-				mn,
-				f.Name,
-			)
-		} else {
-			fs = fmt.Sprintf("func (c *%s) %s() %s {\n\treturn c.%s\n}",
-				s.GoType.Name,
-				mn,
-				f.Type,
-				f.Name,
-			)
+		if f.embedded {
+			continue
+		}
+		mn, rt := f.getter()
+		var body string
+		switch {
+		case f.GoType.overrideStyle == osStruct:
+			body = fmt.Sprintf("return &c.%s", f.Name)
+		case f.IsInterfaceSlice():
+			body = fmt.Sprintf("res := make(%[1]s, len(c.%[2]s))\n\tfor i := range c.%[2]s {\n\t\tres[i] = &c.%[2]s[i]\n\t}\n\treturn res", rt, f.Name)
+		case f.IsBoolPtr():
+			body = fmt.Sprintf("return c.%s != nil && *c.%s", f.Name, f.Name)
+		case f.IsDuration():
+			body = fmt.Sprintf("return c.%s.TimeDuration()", f.Name)
+		default:
+			body = fmt.Sprintf("return c.%s", f.Name)
 		}
-		list = append(list, strings.Replace(f.PrefixedComment(), f.Name, mn, -1))
-		list = append(list, fs)
+		list = append(list, strings.Replace(f.PrefixedComment(), f.Name, mn, -1)+f.FixedComment()+f.DeprecatedComment())
+		list = append(list, fmt.Sprintf("func (c *%s) %s() %s {\n\t%s\n}", s.GoType.Name, mn, rt, body))
 	}
 	return strings.Join(list, "\n")
 }
@@ -179,14 +371,37 @@ func (s *structInfo) GettersImpl() string {
 // this must define a Configuration type, and may also define additional related types to generate
 type configDef struct {
 	// PackageName the name of the go package in the generated code, defaults based on the last path segment if not set
-	PackageName string
+	PackageName string `json:",omitempty"`
 	// Configuration the primary/top level configuration type.
 	Configuration *structInfo
 	// RelatedTypes contains any additional types that are referenced from the Configuration defintion, go structs will be generated for these
-	RelatedTypes map[string]*structInfo
+	RelatedTypes map[string]*structInfo `json:",omitempty"`
+	// Include is a list of other definition files, whose RelatedTypes are merged into this definition
+	Include []includeDef `json:",omitempty"`
+	// ExternalTypes contains go types from other packages that can be used as field types, keyed by the name used as the field type
+	ExternalTypes map[string]*externalTypeDef `json:",omitempty"`
+	// InterfaceGetters if set, the getters of fields whose related type is WithGetter return the
+	// <Type>Config interface instead of *Type, and slices of them return a []<Type>Config
+	InterfaceGetters bool `json:",omitempty"`
+	// Naming is the policy for the keys of the fields in the config files, one of go [the default],
+	// camelCase, snake_case or kebab-case
+	Naming string `json:",omitempty"`
+	// Tags is a list of extra struct tags that are generated for all the fields, e.g. yaml, toml or env
+	Tags []string `json:",omitempty"`
+	// SchemaVersion is the version of the schema of the config files, config files with an older
+	// version are migrated by the loaders with the Migrations
+	SchemaVersion int `json:",omitempty"`
+	// Migrations are the changes to the config files in each schema version, in version order
+	Migrations []migrationDef `json:",omitempty"`
 
 	// customTypeInfos is populated during post processing
 	customTypeInfos map[string]*typeInfo
+	// externalTypeInfos is populated during post processing
+	externalTypeInfos map[string]*typeInfo
+	// files is the list of definition files this was loaded from
+	files []string
+	// index locates the values in the definition file
+	index *jsonIndex
 }
 
 // this is the collection of data exposed to the template to generate the new config.go
@@ -194,6 +409,18 @@ type templateData struct {
 	PackageName string
 	Structs     map[string]*structInfo
 	BaseTypes   typeInfos
+	// Imports contains the import paths of the packages of the external types that are used
+	Imports []string
+	// ExternalTypes contains the external types that are used
+	ExternalTypes typeInfos
+	// Hash identifies the inputs used to generate the code, its recorded in the generated file
+	Hash string
+	// SchemaVersion and Migrations are from the definition
+	SchemaVersion int
+	Migrations    []migrationDef
+
+	// sourceFiles is the list of definition files that were loaded [the main file, and all the included files]
+	sourceFiles []string
 }
 
 // generateConfig will load the config definition file, and generate the resulting
@@ -203,6 +430,41 @@ func generateConfig(defFile, destDir string) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	return errors.Trace(def.generate(destDir))
+}
+
+// templateNames are the names of the code generation templates in the assets
+var templateNames = []string{"/config.go.template", "/config_test.go.template", "/cli.go.template", "/mock_config.go.template", "/mock_config_test.go.template"}
+
+var (
+	templatesOnce sync.Once
+	templates     *template.Template
+	templatesErr  error
+)
+
+// loadTemplates parses the code generation templates, this is only done once
+// regardless of how many configurations are generated
+func loadTemplates() (*template.Template, error) {
+	templatesOnce.Do(func() {
+		templates = template.New("configen")
+		for _, templateName := range templateNames {
+			toParse, ok := assets.String(templateName)
+			if !ok {
+				templatesErr = fmt.Errorf("unable to load template %q", templateName)
+				return
+			}
+			if _, err := templates.New(templateName).Parse(toParse); err != nil {
+				templatesErr = fmt.Errorf("unable to parse template %q: %v", templateName, err)
+				return
+			}
+		}
+	})
+	return templates, templatesErr
+}
+
+// setDefaultPackageName sets the PackageName based on the destination
+// directory, if it wasn't specified in the definition
+func (def *templateData) setDefaultPackageName(destDir string) error {
 	if def.PackageName == "" {
 		defName, err := defaultPackageName(destDir)
 		if err != nil {
@@ -210,19 +472,26 @@ func generateConfig(defFile, destDir string) error {
 		}
 		def.PackageName = defName
 	}
+	return nil
+}
 
-	gen := func(destFilename, templateName string) (string, error) {
-		toParse, ok := assets.String(templateName)
-		if !ok {
-			return "", fmt.Errorf("unable to load template %q", templateName)
-		}
-
-		t := template.New(templateName)
-		_, err = t.Parse(toParse)
-		if err != nil {
-			return "", fmt.Errorf("unable to parse template %q: %v", templateName, err)
+// generate writes the config.go file & config_test.go files for the loaded
+// definition to the supplied directory.
+func (def *templateData) generate(destDir string) error {
+	if err := def.setDefaultPackageName(destDir); err != nil {
+		return errors.Trace(err)
+	}
+	t, err := loadTemplates()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if def.Hash == "" {
+		if def.Hash, err = def.contentHash(); err != nil {
+			return errors.Trace(err)
 		}
+	}
 
+	gen := func(destFilename, templateName string) (string, error) {
 		destFile := filepath.Join(destDir, destFilename)
 		df, err := os.Create(destFile)
 		if err != nil {
@@ -230,15 +499,18 @@ func generateConfig(defFile, destDir string) error {
 		}
 		defer df.Close()
 		if err := t.ExecuteTemplate(df, templateName, def); err != nil {
+			df.Close()
+			os.Remove(destFile)
 			return "", fmt.Errorf("unable to generate code: %v", err)
 		}
 		return destFile, nil
 	}
-	configFile, err := gen("config.go", "/config.go.template")
+	// config.go records the hash of the inputs, so it's written last
+	testFile, err := gen("config_test.go", "/config_test.go.template")
 	if err != nil {
 		return errors.Trace(err)
 	}
-	testFile, err := gen("config_test.go", "/config_test.go.template")
+	configFile, err := gen("config.go", "/config.go.template")
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -260,25 +532,59 @@ func defaultPackageName(dir string) (string, error) {
 // loadConfig will load the supplied config file, parse it, and do some post processing
 // to build the templateData instance.
 func loadConfig(defFile string) (*templateData, error) {
-	// parse the supplied json config file
+	def, err := readDefFile(defFile)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := def.resolveIncludes(defFile, []string{filepath.Clean(defFile)}); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if def.Configuration == nil {
+		return nil, errors.Errorf("configuration definition file %v doesn't define the Configuration type", defFile)
+	}
+	if err := def.hoistInlineTypes(); err != nil {
+		return nil, err
+	}
+	return def.processConfig()
+}
+
+// readDefFile parses the supplied json config definition file, the types
+// defined in it are tagged with the file they came from.
+func readDefFile(defFile string) (*configDef, error) {
 	var def configDef
 	f, err := os.Open(defFile)
 	if err != nil {
 		return nil, errors.Errorf("unable to open supplied configuration definition file %v: %v", defFile, err)
 	}
 	defer f.Close()
-	if err := json.NewDecoder(f).Decode(&def); err != nil {
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, errors.Errorf("unable to read configuration definition file %v: %v", defFile, err)
+	}
+	if err := json.Unmarshal(data, &def); err != nil {
+		ix := &jsonIndex{data: data}
+		e := &definitionError{File: defFile, Msg: fmt.Sprintf("unable to parse configuration definition file: %v", err)}
+		e.Line, e.Column = ix.position(jsonErrorOffset(err, len(data)))
+		return nil, e
+	}
+	if def.index, err = indexJSON(data); err != nil {
 		return nil, errors.Errorf("unable to parse configuration definition file %v: %v", defFile, err)
 	}
-	return def.processConfig()
+	if def.Configuration != nil {
+		def.Configuration.setSource(defFile, def.index, "Configuration")
+	}
+	for n, rt := range def.RelatedTypes {
+		rt.setSource(defFile, def.index, "RelatedTypes."+n)
+	}
+	def.files = []string{defFile}
+	return &def, nil
 }
 
-// getRelatedType will retrieve the related typeinfo based on the type name
+// getRelatedType will retrieve the related typeinfo based on the type name, the type
+// can also be a slice, map or pointer of the related type
 func (def *configDef) getRelatedType(fType string) (*structInfo, bool) {
-	if strings.HasPrefix(fType, "[]") {
-		return def.getRelatedType(fType[2:])
-	}
-	rt, ok := def.RelatedTypes[fType]
+	_, typ := splitTypePrefix(fType)
+	rt, ok := def.RelatedTypes[typ]
 	return rt, ok
 }
 
@@ -287,13 +593,35 @@ func (def *configDef) getRelatedType(fType string) (*structInfo, bool) {
 // the referenced types exist
 func (def *configDef) processConfig() (*templateData, error) {
 	def.customTypeInfos = make(map[string]*typeInfo)
-	usedTypes := make(map[string]*typeInfo) // type Name -> Type
-	processField := func(f *fieldInfo) error {
+	if err := def.validateExternalTypes(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	usedTypes := make(map[string]*typeInfo)     // type Name -> Type
+	usedExternals := make(map[string]*typeInfo) // type Name -> Type
+	var errs definitionErrors
+	processField := func(s *structInfo, structName string, idx int) {
+		f := &s.Fields[idx]
 		ti, ok := stdTypesByName[f.Type]
+		if et, isExternal := def.externalTypeInfos[f.Type]; !ok && isExternal {
+			ti, ok = et, true
+			usedExternals[f.Type] = et
+		}
+		if f.embedded {
+			rt, ok := def.RelatedTypes[f.Type]
+			if !ok {
+				errs = append(errs, s.errorf(structName, s.fieldPath(idx, ""),
+					"embedded type %v of %v isn't valid, it must be the name of a related type %v", f.Type, structName, def.relatedTypesHint(f.Type)))
+				return
+			}
+			f.GoType = def.ensureRelatedTypeInfo(f.Type, rt)
+			return
+		}
 		if !ok {
 			rt, ok := def.getRelatedType(f.Type)
 			if !ok {
-				return errors.Errorf("field %v has type %v which isn't valid (valid types are %v)", f.Name, f.Type, strings.Join(stdTypeNames(), ","))
+				errs = append(errs, s.errorf(structName, s.fieldPath(idx, "type"),
+					"field %v of %v has type %v which isn't valid %v", f.Name, structName, f.Type, def.validTypesHint(f.Type)))
+				return
 			}
 			ti = def.ensureRelatedTypeInfo(f.Type, rt)
 		}
@@ -301,28 +629,63 @@ func (def *configDef) processConfig() (*templateData, error) {
 			usedTypes[f.Type] = ti
 		}
 		f.GoType = ti
-		return nil
+	}
+	def.Configuration.embedFields()
+	for _, rt := range def.RelatedTypes {
+		rt.embedFields()
 	}
 	for idx := range def.Configuration.Fields {
-		if err := processField(&def.Configuration.Fields[idx]); err != nil {
-			return nil, errors.Trace(err)
-		}
+		processField(def.Configuration, "Configuration", idx)
 	}
 	def.ensureRelatedTypeInfo("Configuration", def.Configuration)
 	res := templateData{
-		PackageName: def.PackageName,
-		Structs:     map[string]*structInfo{"Configuration": def.Configuration},
+		PackageName:   def.PackageName,
+		Structs:       map[string]*structInfo{"Configuration": def.Configuration},
+		sourceFiles:   def.files,
+		SchemaVersion: def.SchemaVersion,
+		Migrations:    def.Migrations,
 	}
 	for tn, td := range def.RelatedTypes {
 		for idx := range td.Fields {
-			if err := processField(&td.Fields[idx]); err != nil {
-				return nil, errors.Trace(err)
-			}
+			processField(td, tn, idx)
 		}
 		res.Structs[tn] = td
 		def.ensureRelatedTypeInfo(tn, td)
 	}
-
+	for tn, td := range res.Structs {
+		errs = append(errs, td.validateFieldNames(tn)...)
+	}
+	errs = append(errs, valueCycles(res.Structs)...)
+	if len(errs) == 0 {
+		for tn, td := range res.Structs {
+			errs = append(errs, td.validateEmbeds(tn)...)
+		}
+	}
+	if len(errs) == 0 {
+		errs = def.applyNaming(res.Structs)
+	}
+	if len(errs) == 0 {
+		errs = applyDeprecations(res.Structs)
+	}
+	if len(errs) == 0 {
+		applyFixed(res.Structs)
+	}
+	if len(errs) == 0 {
+		errs = def.validateMigrations()
+	}
+	if len(errs) > 0 {
+		errs.sort()
+		return nil, errs
+	}
+	if def.InterfaceGetters {
+		for _, st := range res.Structs {
+			for idx := range st.Fields {
+				f := &st.Fields[idx]
+				// the getters of maps and pointers return the field as is
+				f.interfaceGetter = f.GoType.structDef != nil && f.GoType.structDef.WithGetter && !f.GoType.IsMap() && !f.GoType.IsPointer()
+			}
+		}
+	}
 	def.populateStructExamples()
 	typeList := make([]*typeInfo, 0, len(usedTypes))
 	for _, t := range usedTypes {
@@ -330,51 +693,157 @@ func (def *configDef) processConfig() (*templateData, error) {
 	}
 	sort.Sort(typeInfos(typeList))
 	res.BaseTypes = typeList
+
+	imports := make(map[string]bool)
+	for _, t := range usedExternals {
+		res.ExternalTypes = append(res.ExternalTypes, t)
+		imports[t.external.Import] = true
+	}
+	sort.Sort(res.ExternalTypes)
+	for imp := range imports {
+		res.Imports = append(res.Imports, imp)
+	}
+	sort.Strings(res.Imports)
 	return &res, nil
 }
 
+// ExtraImports pipe returns the Imports that are not in the supplied list of
+// packages that the template already imports
+func (td *templateData) ExtraImports(existing ...string) []string {
+	res := make([]string, 0, len(td.Imports))
+	for _, imp := range td.Imports {
+		found := false
+		for _, e := range existing {
+			found = found || e == imp
+		}
+		if !found {
+			res = append(res, imp)
+		}
+	}
+	return res
+}
+
+// errorf returns an error for the value with the json path in the definition file
+func (def *configDef) errorf(path, format string, args ...interface{}) *definitionError {
+	file := ""
+	if len(def.files) > 0 {
+		file = def.files[0]
+	}
+	return newDefinitionError(file, def.index, "", path, format, args...)
+}
+
+// validTypesHint returns the suggested type for an invalid field type, or
+// the list of valid types if there isn't a similar one
+func (def *configDef) validTypesHint(typ string) string {
+	names := def.typeNames()
+	related := make([]string, 0, len(def.RelatedTypes)*(len(relatedTypePrefixes)+1))
+	for n := range def.RelatedTypes {
+		related = append(related, n)
+		for _, p := range relatedTypePrefixes {
+			related = append(related, p+n)
+		}
+	}
+	if s := closestName(typ, append(names, related...)); s != "" {
+		return fmt.Sprintf("(did you mean %s?)", s)
+	}
+	return fmt.Sprintf("(valid types are %v)", strings.Join(names, ","))
+}
+
+// relatedTypesHint returns the suggested related type for an invalid embedded type,
+// or the list of related types if there isn't a similar one
+func (def *configDef) relatedTypesHint(typ string) string {
+	related := make([]string, 0, len(def.RelatedTypes))
+	for n := range def.RelatedTypes {
+		related = append(related, n)
+	}
+	sort.Strings(related)
+	if s := closestName(typ, related); s != "" {
+		return fmt.Sprintf("(did you mean %s?)", s)
+	}
+	return fmt.Sprintf("(related types are %v)", strings.Join(related, ","))
+}
+
+// typeNames returns the names of all the types that can be used for a field,
+// other than the related types
+func (def *configDef) typeNames() []string {
+	names := stdTypeNames()
+	ext := make([]string, 0, len(def.ExternalTypes))
+	for n := range def.ExternalTypes {
+		ext = append(ext, n)
+	}
+	sort.Strings(ext)
+	return append(names, ext...)
+}
+
+// maxExampleDepth is the number of times a recursive type [e.g. a struct with a
+// []Self field] is nested in its examples, the slice, map or pointer is empty in the
+// innermost one
+const maxExampleDepth = 2
+
 // populateStructExamples goes through all the struct types that we're going to generate
 // and populates the ExampleValues field for them [the ExampleValues are used to generate
 // unit tests].
-// We can only generate an ExampleValue for a type once there are ExampleValues available
-// for all the fields in the Type, so to handle cases where struct A contains a struct B
-// we loop over all the types, doing the ones we can, then go back and try them again
-// and so on until they're all done.
+// The examples of a struct are built from the examples of its fields, so to handle cases
+// where struct A contains a struct B, the examples of B are built first, recursive types
+// are nested up to maxExampleDepth times.
 func (def *configDef) populateStructExamples() {
-	for tries := 0; tries <= len(def.customTypeInfos); tries++ {
-		completed := true
-		for _, t := range def.customTypeInfos {
-			if len(t.ExampleValues) == 0 {
-				// note that its in this order so that populate gets called regardless of the value of completed
-				completed = populateStructExample(t) && completed
-			}
-		}
-		if completed {
-			return
-		}
+	names := make([]string, 0, len(def.customTypeInfos))
+	for n := range def.customTypeInfos {
+		names = append(names, n)
 	}
-	missing := make([]string, 0, 10)
-	for _, t := range def.customTypeInfos {
-		if len(t.ExampleValues) == 0 {
-			missing = append(missing, t.Name)
+	// the structs are done before the slices, maps and pointers, so that the examples
+	// of a recursive type aren't built from those of the types that refer to it
+	sort.Slice(names, func(a, b int) bool {
+		pa, _ := splitTypePrefix(names[a])
+		pb, _ := splitTypePrefix(names[b])
+		if (pa == "") != (pb == "") {
+			return pa == ""
 		}
+		return names[a] < names[b]
+	})
+	for _, n := range names {
+		structExamples(def.customTypeInfos[n], nil)
 	}
-	log.Fatalf("after %d tries, still unable to generate all the ExampleValues, %v still don't have any", len(def.customTypeInfos), missing)
 }
 
-// populateStructExample updates the typeInfo with ExampleValues and returns true
-// or returns false if it was unable to [because there are missing example from
-// other types]
+// structExamples returns the ExampleValues for the struct [or slice, map or pointer of struct] type,
+// stack is the list of structs that this one is nested in. The examples are recorded
+// in the typeInfo unless they were cut short by maxExampleDepth, in which case they
+// depend on where the type is nested, and truncated is returned as true.
 // the base types get 3 examples, we get 6, 3 are fully populated, and 3 only populate
 // a subset of fields
-func populateStructExample(t *typeInfo) bool {
+func structExamples(t *typeInfo, stack []*structInfo) (examples []string, truncated bool) {
+	if len(t.ExampleValues) > 0 || t.structDef == nil {
+		return t.ExampleValues, false
+	}
+	nested := append(append(make([]*structInfo, 0, len(stack)+1), stack...), t.structDef)
+	fieldExamples := make([][]string, len(t.structDef.Fields))
+	for fidx, f := range t.structDef.Fields {
+		if f.GoType.structDef == nil {
+			fieldExamples[fidx] = f.GoType.ExampleValues
+			continue
+		}
+		if f.GoType.isIndirect() && nestedCount(nested, f.GoType.structDef) >= maxExampleDepth {
+			truncated = true
+			continue
+		}
+		fe, tr := structExamples(f.GoType, nested)
+		fieldExamples[fidx] = fe
+		truncated = truncated || tr
+	}
+
 	r := &bytes.Buffer{}
-	build := func(idx int, maxFields int) (string, bool) {
+	build := func(idx int, maxFields int) string {
 		r.Reset()
-		isArray := strings.HasPrefix(t.Name, "[]")
-		if isArray {
+		prefix, _ := splitTypePrefix(t.Name)
+		switch prefix {
+		case "[]":
 			fmt.Fprintf(r, "%s{\n{\n", t.Name)
-		} else {
+		case "map[string]":
+			fmt.Fprintf(r, "%s{\n%q: {\n", t.Name, exampleMapKey)
+		case "*":
+			fmt.Fprintf(r, "&%s{\n", t.structDef.GoType.Name)
+		default:
 			fmt.Fprintf(r, "%s{\n", t.Name)
 		}
 		delim := ""
@@ -382,38 +851,49 @@ func populateStructExample(t *typeInfo) bool {
 			if fidx >= maxFields {
 				break
 			}
-			fe := f.GoType.ExampleValues
-			if len(fe) == 0 {
-				return "", false
+			if fieldExamples[fidx] == nil {
+				continue
 			}
-			fmt.Fprintf(r, "%s%s: %s", delim, f.Name, fe[idx])
+			fmt.Fprintf(r, "%s%s: %s", delim, f.Name, fieldExamples[fidx][idx])
 			delim = ",\n"
 		}
-		if isArray {
+		if prefix == "[]" || prefix == "map[string]" {
 			fmt.Fprint(r, "},\n}")
 		} else {
 			r.WriteByte('}')
 		}
-		return r.String(), true
+		return r.String()
 	}
 	res := make([]string, 0, 6)
 	for i := 0; i < 3; i++ {
-		ex, ok := build(i, 5000)
-		if !ok {
-			return false
-		}
-		res = append(res, ex)
+		res = append(res, build(i, 5000))
 	}
 	for i := 0; i < 3; i++ {
-		ex, _ := build(i, i+1)
-		res = append(res, ex)
+		res = append(res, build(i, i+1))
+	}
+	if !truncated || len(stack) == 0 {
+		t.ExampleValues = res
+	}
+	return res, truncated
+}
+
+// exampleMapKey is the key of the value in the examples of the maps of related types,
+// the examples use the same key so that they can be compared by path
+const exampleMapKey = "example"
+
+// nestedCount returns the number of times s is in the stack
+func nestedCount(stack []*structInfo, s *structInfo) int {
+	c := 0
+	for _, n := range stack {
+		if n == s {
+			c++
+		}
 	}
-	t.ExampleValues = res
-	return true
+	return c
 }
 
-// we need a typeInfo instance for this custom struct type (or slice of custom struct),
-// this method will create it [or return a previously created one]
+// we need a typeInfo instance for this custom struct type (or slice, map or pointer of
+// custom struct), this method will create it [or return a previously created one]
 func (def *configDef) ensureRelatedTypeInfo(name string, structDef *structInfo) *typeInfo {
 	if existing, exists := def.customTypeInfos[name]; exists {
 		return existing
@@ -423,12 +903,21 @@ func (def *configDef) ensureRelatedTypeInfo(name string, structDef *structInfo)
 		overrideStyle: osStruct,
 		structDef:     structDef,
 	}
-	if strings.HasPrefix(name, "[]") {
-		typ := name[2:]
+	// slices and maps are replaced by a non empty override, and pointers by a non nil one
+	switch prefix, typ := splitTypePrefix(name); prefix {
+	case "[]":
 		t.OverrideFunc = "override" + typ + "Slice"
 		t.overrideStyle = osLen
 		def.ensureRelatedTypeInfo(typ, structDef)
-	} else {
+	case "map[string]":
+		t.OverrideFunc = "override" + typ + "Map"
+		t.overrideStyle = osLen
+		def.ensureRelatedTypeInfo(typ, structDef)
+	case "*":
+		t.OverrideFunc = "override" + typ + "Ptr"
+		t.overrideStyle = osCompareNil
+		def.ensureRelatedTypeInfo(typ, structDef)
+	default:
 		structDef.GoType = &t
 	}
 	def.customTypeInfos[name] = &t
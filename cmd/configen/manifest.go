@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/go-phorce/configen/version"
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// this file contains the manifest mode, where a single invocation generates
+// the code for many definition files, e.g. all the services in a monorepo.
+
+// hashPrefix is the prefix of the comment in the generated config.go that
+// records the hash of the inputs used to generate it
+const hashPrefix = "// configen:hash "
+
+// manifest is the list of targets loaded from the manifest file
+//
+//	targets:
+//	  - definition: services/a/config_def.json
+//	    output: services/a/config
+//	    sample: true
+//	    mocks: true
+type manifest struct {
+	Targets []manifestTarget `yaml:"targets"`
+}
+
+// manifestTarget is a definition file, and the directory to write the generated files to
+type manifestTarget struct {
+	// Definition is the filename of the configuration definition, relative to the manifest file
+	Definition string `yaml:"definition"`
+	// Output is the directory to write the generated files to, relative to the manifest file
+	Output string `yaml:"output"`
+	// Sample if set, the sample config files are also written
+	Sample bool `yaml:"sample"`
+	// Mocks if set, the mocks of the getter interfaces are also written
+	Mocks bool `yaml:"mocks"`
+}
+
+// targetResult is the outcome of generating a single target
+type targetResult struct {
+	Target manifestTarget
+	// Unchanged is true if the generated files are up to date and were not written
+	Unchanged bool
+	Err       error
+}
+
+// runManifest generates all the targets in the manifest file, the result of
+// each target is reported, and an error is returned if any of them failed.
+func runManifest(manifestFile string, force bool) error {
+	m, err := loadManifest(manifestFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	failed := 0
+	for _, r := range m.generate(force) {
+		switch {
+		case r.Err != nil:
+			failed++
+			fmt.Printf("%s -> %s: FAILED: %v\n", r.Target.Definition, r.Target.Output, r.Err)
+		case r.Unchanged:
+			fmt.Printf("%s -> %s: unchanged\n", r.Target.Definition, r.Target.Output)
+		default:
+			fmt.Printf("%s -> %s: generated\n", r.Target.Definition, r.Target.Output)
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("%d of %d targets failed", failed, len(m.Targets))
+	}
+	return nil
+}
+
+// loadManifest parses the manifest file, relative paths in the targets are
+// resolved relative to the manifest file.
+func loadManifest(manifestFile string) (*manifest, error) {
+	b, err := ioutil.ReadFile(manifestFile)
+	if err != nil {
+		return nil, errors.Errorf("unable to read manifest file %v: %v", manifestFile, err)
+	}
+	var m manifest
+	if err = yaml.Unmarshal(b, &m); err != nil {
+		return nil, errors.Errorf("unable to parse manifest file %v: %v", manifestFile, err)
+	}
+	baseDir := filepath.Dir(manifestFile)
+	for idx := range m.Targets {
+		t := &m.Targets[idx]
+		if t.Definition == "" {
+			return nil, errors.Errorf("target %d in manifest file %v doesn't specify the definition", idx, manifestFile)
+		}
+		if t.Output == "" {
+			t.Output = filepath.Dir(t.Definition)
+		}
+		if !filepath.IsAbs(t.Definition) {
+			t.Definition = filepath.Join(baseDir, t.Definition)
+		}
+		if !filepath.IsAbs(t.Output) {
+			t.Output = filepath.Join(baseDir, t.Output)
+		}
+	}
+	return &m, nil
+}
+
+// generate runs all the targets concurrently, the results are in the same
+// order as the targets.
+func (m *manifest) generate(force bool) []targetResult {
+	results := make([]targetResult, len(m.Targets))
+	sem := make(chan struct{}, runtime.NumCPU())
+	var wg sync.WaitGroup
+	for idx := range m.Targets {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			t := m.Targets[idx]
+			unchanged, err := t.generate(force)
+			results[idx] = targetResult{Target: t, Unchanged: unchanged, Err: err}
+		}(idx)
+	}
+	wg.Wait()
+	return results
+}
+
+// generate writes the generated files for the target, unless force is false
+// and the existing generated files were generated from the same inputs, in
+// which case it returns true.
+func (t *manifestTarget) generate(force bool) (bool, error) {
+	def, err := loadConfig(t.Definition)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if err = def.setDefaultPackageName(t.Output); err != nil {
+		return false, errors.Trace(err)
+	}
+	if def.Hash, err = def.contentHash(); err != nil {
+		return false, errors.Trace(err)
+	}
+	if !force && t.upToDate(def.Hash) {
+		return true, nil
+	}
+	if err = os.MkdirAll(t.Output, 0775); err != nil {
+		return false, errors.Errorf("unable to create output directory: %v", err)
+	}
+	if t.Sample {
+		if err = def.writeSample(t.Output); err != nil {
+			return false, errors.Trace(err)
+		}
+	}
+	if t.Mocks {
+		if err = def.writeMocks(t.Output); err != nil {
+			return false, errors.Trace(err)
+		}
+	}
+	// config.go records the hash, so it's written last, if any of the other files
+	// fail, the target isn't up to date on the next run
+	if err = def.generate(t.Output); err != nil {
+		return false, errors.Trace(err)
+	}
+	return false, nil
+}
+
+// upToDate returns true if all the generated files for the target exist, and
+// they were generated from inputs with the supplied hash
+func (t *manifestTarget) upToDate(hash string) bool {
+	files := []string{"config_test.go"}
+	if t.Sample {
+		files = append(files, "config.sample.json", "config.sample.yaml")
+	}
+	if t.Mocks {
+		files = append(files, "mock_config.go", "mock_config_test.go")
+	}
+	for _, f := range files {
+		if _, err := os.Stat(filepath.Join(t.Output, f)); err != nil {
+			return false
+		}
+	}
+	return existingHash(filepath.Join(t.Output, "config.go")) == hash
+}
+
+// existingHash returns the hash recorded in a previously generated file, or
+// an empty string if there isn't one.
+func existingHash(filename string) string {
+	f, err := os.Open(filename)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if l := s.Text(); strings.HasPrefix(l, hashPrefix) {
+			return strings.TrimSpace(l[len(hashPrefix):])
+		}
+	}
+	return ""
+}
+
+// contentHash returns a hash of all the inputs to the code generation, the
+// configen version, templates, package name and definition files [including
+// any included files]
+func (def *templateData) contentHash() (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n", version.Current(), def.PackageName)
+	for _, name := range templateNames {
+		t, _ := assets.String(name)
+		h.Write([]byte(t))
+	}
+	for _, fn := range def.sourceFiles {
+		b, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return "", errors.Errorf("unable to read definition file %v: %v", fn, err)
+		}
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
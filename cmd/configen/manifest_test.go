@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Manifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	noRels, err := filepath.Abs("testdata/gen_no_rels.json")
+	require.NoError(t, err)
+	include, err := filepath.Abs("testdata/gen_include.json")
+	require.NoError(t, err)
+	invalid, err := filepath.Abs("testdata/invalid_type_test.json")
+	require.NoError(t, err)
+
+	manifestFile := filepath.Join(dir, "configen.yaml")
+	err = ioutil.WriteFile(manifestFile, []byte(fmt.Sprintf(`
+targets:
+  - definition: %s
+    output: norels
+  - definition: %s
+    output: include
+    sample: true
+  - definition: %s
+    output: invalid
+`, noRels, include, invalid)), 0664)
+	require.NoError(t, err)
+
+	m, err := loadManifest(manifestFile)
+	require.NoError(t, err)
+	require.Len(t, m.Targets, 3)
+	assert.Equal(t, filepath.Join(dir, "norels"), m.Targets[0].Output)
+
+	res := m.generate(false)
+	require.Len(t, res, 3)
+	assert.NoError(t, res[0].Err)
+	assert.False(t, res[0].Unchanged)
+	assert.NoError(t, res[1].Err)
+	require.Error(t, res[2].Err)
+	assert.Contains(t, res[2].Err.Error(), "field bob of Configuration has type Alice which isn't valid")
+
+	for _, f := range []string{"norels/config.go", "norels/config_test.go", "include/config.go", "include/config.sample.yaml"} {
+		_, err = os.Stat(filepath.Join(dir, f))
+		assert.NoError(t, err, f)
+	}
+	hash := existingHash(filepath.Join(dir, "norels", "config.go"))
+	assert.Len(t, hash, 64)
+
+	// everything is unchanged when run a second time
+	res = m.generate(false)
+	assert.True(t, res[0].Unchanged)
+	assert.True(t, res[1].Unchanged)
+	assert.Error(t, res[2].Err)
+
+	// the sample files are missing
+	require.NoError(t, os.Remove(filepath.Join(dir, "include", "config.sample.json")))
+	res = m.generate(false)
+	assert.True(t, res[0].Unchanged)
+	assert.False(t, res[1].Unchanged)
+
+	// force regenerates everything
+	res = m.generate(true)
+	assert.False(t, res[0].Unchanged)
+	assert.False(t, res[1].Unchanged)
+	assert.Equal(t, hash, existingHash(filepath.Join(dir, "norels", "config.go")))
+
+	err = runManifest(manifestFile, false)
+	require.Error(t, err)
+	assert.Equal(t, "1 of 3 targets failed", err.Error())
+}
+
+func Test_ManifestPartialFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	getters, err := ioutil.ReadFile("testdata/gen_deprecated.json")
+	require.NoError(t, err)
+	noGetters, err := ioutil.ReadFile("testdata/gen_no_rels.json")
+	require.NoError(t, err)
+	defFile := filepath.Join(dir, "def.json")
+	require.NoError(t, ioutil.WriteFile(defFile, getters, 0664))
+	manifestFile := filepath.Join(dir, "configen.yaml")
+	require.NoError(t, ioutil.WriteFile(manifestFile, []byte("targets:\n  - definition: def.json\n    output: out\n    mocks: true\n"), 0664))
+	m, err := loadManifest(manifestFile)
+	require.NoError(t, err)
+
+	res := m.generate(false)
+	require.NoError(t, res[0].Err)
+	hash := existingHash(filepath.Join(dir, "out", "config.go"))
+
+	// the mocks fail, so config.go shouldn't be updated with the new hash
+	require.NoError(t, ioutil.WriteFile(defFile, noGetters, 0664))
+	res = m.generate(false)
+	require.Error(t, res[0].Err)
+	assert.Equal(t, hash, existingHash(filepath.Join(dir, "out", "config.go")))
+	res = m.generate(false)
+	require.Error(t, res[0].Err, "the target shouldn't be up to date after a failure")
+	assert.False(t, res[0].Unchanged)
+}
+
+func Test_ManifestInvalid(t *testing.T) {
+	_, err := loadManifest("testdata/missing.yaml")
+	require.Error(t, err)
+	assert.Equal(t, "unable to read manifest file testdata/missing.yaml: open testdata/missing.yaml: no such file or directory", err.Error())
+
+	f, err := ioutil.TempFile("", "manifest")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString("targets:\n  - output: bob\n")
+	f.Close()
+	_, err = loadManifest(f.Name())
+	require.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("target 0 in manifest file %s doesn't specify the definition", f.Name()), err.Error())
+}
+
+func Test_ContentHash(t *testing.T) {
+	td, err := loadConfig("testdata/gen_include.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"testdata/gen_include.json", "testdata/common/http.json", "testdata/common/tls.json", "testdata/common/tls.json", "testdata/common/tls.json", "testdata/common/logger.json"}, td.sourceFiles)
+
+	h1, err := td.contentHash()
+	require.NoError(t, err)
+	td.PackageName = "other"
+	h2, err := td.contentHash()
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, h2)
+
+	assert.Empty(t, existingHash("testdata/missing.go"))
+}
@@ -0,0 +1,558 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// this file contains the support for versioned config files, the definition has
+// a SchemaVersion, and the Migrations that change the config files from one version
+// to the next, the generated loaders apply the migrations to config files with an
+// older version before they're decoded, and the migrate command rewrites config
+// files to the current version.
+
+// the operations of a migration
+const (
+	opRename  = "rename"
+	opMove    = "move"
+	opConvert = "convert"
+	opRemove  = "remove"
+)
+
+// migrationOps are the valid values of the Op of a migration
+var migrationOps = []string{opRename, opMove, opConvert, opRemove}
+
+// conversions are the valid values of the Conversion of a convert migration
+var conversions = []string{"seconds", "milliseconds", "string", "number"}
+
+// migrationDef is a change to the config files in a schema version
+type migrationDef struct {
+	// Version is the schema version that the migration is part of, it's applied to config files with an older version
+	Version int
+	// Op is one of rename, move, convert or remove
+	Op string
+	// Path is the path of the json keys of the value in a Configuration, e.g. HTTP.Address,
+	// arrays along the path are migrated element by element
+	Path string
+	// To is the new key of a renamed value, or the new path of a moved value
+	To string `json:",omitempty"`
+	// Conversion is the conversion of a converted value, one of seconds or milliseconds [a number to a
+	// Duration], string [a number or bool to a string], or number [a string to a number]
+	Conversion string `json:",omitempty"`
+}
+
+// migrationStep is a migration in the form that's used by the generated code, a rename
+// is a move within the same object, the value is moved within each of the objects at
+// the first shared keys of path and to
+type migrationStep struct {
+	version    int
+	op         string
+	path, to   []string
+	shared     int
+	conversion string
+}
+
+// step returns the migration as a migrationStep
+func (m *migrationDef) step() migrationStep {
+	path := strings.Split(m.Path, ".")
+	res := migrationStep{version: m.Version, op: m.Op, path: path, shared: len(path) - 1, conversion: m.Conversion}
+	switch m.Op {
+	case opRename:
+		res.op = opMove
+		res.to = append(append([]string{}, path[:len(path)-1]...), m.To)
+	case opMove:
+		res.to = strings.Split(m.To, ".")
+		res.shared = 0
+		for res.shared < len(path)-1 && res.shared < len(res.to)-1 && path[res.shared] == res.to[res.shared] {
+			res.shared++
+		}
+	}
+	return res
+}
+
+// validateMigrations returns errors for a SchemaVersion or migrations that aren't valid,
+// the Configuration can't have a field with the version or Defaults key, as they're used
+// to find the version, and to tell the config files from the override files
+func (def *configDef) validateMigrations() definitionErrors {
+	var errs definitionErrors
+	if def.SchemaVersion < 0 {
+		errs = append(errs, def.errorf("SchemaVersion", "schema version %d isn't valid, it should be a positive integer", def.SchemaVersion))
+	}
+	if def.SchemaVersion <= 0 {
+		if len(def.Migrations) > 0 {
+			errs = append(errs, def.errorf("Migrations", "migrations can only be used with a SchemaVersion"))
+		}
+		return errs
+	}
+	for _, kn := range def.Configuration.promotedKeys() {
+		if strings.EqualFold(kn[0], "version") || strings.EqualFold(kn[0], "Defaults") {
+			errs = append(errs, def.errorf("SchemaVersion", "the field %s of Configuration has the key %s, which can't be used with a SchemaVersion", kn[1], kn[0]))
+		}
+	}
+	prev := 0
+	for idx, m := range def.Migrations {
+		path := fmt.Sprintf("Migrations[%d]", idx)
+		switch {
+		case m.Version < 2 || m.Version > def.SchemaVersion:
+			errs = append(errs, def.errorf(path+".Version", "migration version %d isn't valid, it should be from 2 to the SchemaVersion %d", m.Version, def.SchemaVersion))
+		case m.Version < prev:
+			errs = append(errs, def.errorf(path+".Version", "migration version %d is before the version %d of the previous migration, they should be in version order", m.Version, prev))
+		}
+		prev = m.Version
+		if !validMigrationPath(m.Path) {
+			errs = append(errs, def.errorf(path+".Path", "path %q isn't valid, it should be the json keys of the value separated by .", m.Path))
+		}
+		switch m.Op {
+		case opRename:
+			if m.To == "" || strings.Contains(m.To, ".") {
+				errs = append(errs, def.errorf(path+".To", "the new key %q of a rename isn't valid, use a move to change the path", m.To))
+			}
+		case opMove:
+			if !validMigrationPath(m.To) {
+				errs = append(errs, def.errorf(path+".To", "path %q isn't valid, it should be the json keys of the value separated by .", m.To))
+			}
+		case opConvert:
+			if !contains(conversions, m.Conversion) {
+				errs = append(errs, def.errorf(path+".Conversion", "conversion %q isn't valid, it should be one of %s", m.Conversion, strings.Join(conversions, ", ")))
+			}
+		case opRemove:
+		default:
+			errs = append(errs, def.errorf(path+".Op", "migration op %q isn't valid, it should be one of %s", m.Op, strings.Join(migrationOps, ", ")))
+		}
+	}
+	return errs
+}
+
+// validMigrationPath returns true if none of the keys of the path are empty
+func validMigrationPath(path string) bool {
+	for _, k := range strings.Split(path, ".") {
+		if k == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// contains returns true if list contains s
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrationEntries pipe returns the go source of the entries of the generated migrations table
+func (td *templateData) MigrationEntries() []string {
+	res := make([]string, len(td.Migrations))
+	for idx := range td.Migrations {
+		s := td.Migrations[idx].step()
+		e := fmt.Sprintf("{version: %d, op: %q, path: %#v", s.version, s.op, s.path)
+		if s.to != nil {
+			e += fmt.Sprintf(", to: %#v", s.to)
+		}
+		if s.shared > 0 {
+			e += fmt.Sprintf(", shared: %d", s.shared)
+		}
+		if s.conversion != "" {
+			e += fmt.Sprintf(", conversion: %q", s.conversion)
+		}
+		res[idx] = e + "}"
+	}
+	return res
+}
+
+// runMigrate implements the migrate command, it rewrites the config files that have an
+// older version in place, files with a Defaults key are migrated as config files, and
+// other files as override files
+// usage configen migrate -c <config_def.json> <config.json> ...
+func runMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	defFile := flags.String("c", "", "Filename of the configuration definition file")
+	flags.Parse(args)
+	if *defFile == "" || flags.NArg() == 0 {
+		return errors.New("usage: configen migrate -c <config_def.json> <config.json> ...")
+	}
+	td, err := loadConfig(*defFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if td.SchemaVersion == 0 {
+		return errors.Errorf("%s doesn't have a SchemaVersion", *defFile)
+	}
+	for _, fn := range flags.Args() {
+		if err := td.migrateFile(fn, os.Stdout); err != nil {
+			return errors.Annotate(err, fn)
+		}
+	}
+	return nil
+}
+
+// migrateFile applies the migrations to the config file fn, and rewrites it with
+// the SchemaVersion, the result is reported to w
+func (td *templateData) migrateFile(fn string, w io.Writer) error {
+	b, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	// the numbers are kept as they are in the file
+	d.UseNumber()
+	v, err := decodeOrdered(d)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	doc, ok := v.(*orderedObject)
+	if !ok {
+		return errors.New("the config file should contain a json object")
+	}
+	version, err := td.migrateDoc(doc)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if version == td.SchemaVersion {
+		fmt.Fprintf(w, "%s: is already at version %d\n", fn, version)
+		return nil
+	}
+	var out bytes.Buffer
+	doc.write(&out, "")
+	out.WriteByte('\n')
+	st, err := os.Stat(fn)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = ioutil.WriteFile(fn, out.Bytes(), st.Mode()); err != nil {
+		return errors.Trace(err)
+	}
+	fmt.Fprintf(w, "%s: migrated from version %d to %d\n", fn, version, td.SchemaVersion)
+	return nil
+}
+
+// migrateDoc applies the migrations for the version of doc, and sets its version to
+// the SchemaVersion, it returns the version that doc had
+func (td *templateData) migrateDoc(doc *orderedObject) (int, error) {
+	version := 1
+	if k, ok := doc.key("version"); ok {
+		v, ok := jsonNumber(doc.values[k])
+		if !ok || v < 1 || v != float64(int(v)) {
+			return 0, errors.Errorf("the version of the config file %v isn't valid, it should be a positive integer", doc.values[k])
+		}
+		version = int(v)
+		doc.remove(k)
+	}
+	if version > td.SchemaVersion {
+		return 0, errors.Errorf("the config file has version %d, which is newer than the schema version %d", version, td.SchemaVersion)
+	}
+	configs := []*orderedObject{doc}
+	if k, ok := doc.key("Defaults"); ok {
+		configs = orderedObjects(doc.values[k], nil)
+		if k, ok := doc.key("Overrides"); ok {
+			if overrides, ok := doc.values[k].(*orderedObject); ok {
+				for _, n := range overrides.keys {
+					configs = append(configs, orderedObjects(overrides.values[n], nil)...)
+				}
+			}
+		}
+	}
+	for idx := range td.Migrations {
+		m := td.Migrations[idx].step()
+		if m.version <= version {
+			continue
+		}
+		for _, c := range configs {
+			if err := m.apply(c); err != nil {
+				return 0, errors.Trace(err)
+			}
+		}
+	}
+	doc.keys = append([]string{"version"}, doc.keys...)
+	doc.values["version"] = td.SchemaVersion
+	return version, nil
+}
+
+// apply applies the migration to c, the json of a Configuration, this is the same
+// as the apply method of the generated migration type
+func (m *migrationStep) apply(c *orderedObject) error {
+	for _, obj := range orderedObjects(c, m.path[:m.shared]) {
+		path := m.path[m.shared:]
+		switch m.op {
+		case opMove:
+			if len(path) == 1 && len(m.to) == m.shared+1 {
+				obj.rename(path[0], m.to[m.shared])
+			} else if v, ok := obj.take(path); ok {
+				obj.put(m.to[m.shared:], v)
+			}
+		case opRemove:
+			obj.take(path)
+		case opConvert:
+			k, ok := obj.key(path[0])
+			if !ok {
+				continue
+			}
+			v, err := convertJSON(obj.values[k], m.conversion)
+			if err != nil {
+				return errors.Errorf("%s: %v", strings.Join(m.path, "."), err)
+			}
+			obj.values[k] = v
+		}
+	}
+	return nil
+}
+
+// convertJSON converts v with the conversion of a migration, values that already
+// have the new type are unchanged
+func convertJSON(v interface{}, conversion string) (interface{}, error) {
+	switch conversion {
+	case "seconds", "milliseconds":
+		if n, ok := jsonNumber(v); ok {
+			unit := time.Second
+			if conversion == "milliseconds" {
+				unit = time.Millisecond
+			}
+			return time.Duration(n * float64(unit)).String(), nil
+		}
+	case "string":
+		switch tv := v.(type) {
+		case json.Number:
+			return tv.String(), nil
+		case float64:
+			return strconv.FormatFloat(tv, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(tv), nil
+		}
+	case "number":
+		if s, ok := v.(string); ok {
+			if _, err := strconv.ParseFloat(s, 64); err != nil {
+				return nil, errors.Errorf("%q isn't a number", s)
+			}
+			return json.Number(s), nil
+		}
+	}
+	return v, nil
+}
+
+// jsonNumber returns the value of v if it's a number
+func jsonNumber(v interface{}) (float64, bool) {
+	switch tv := v.(type) {
+	case float64:
+		return tv, true
+	case json.Number:
+		f, err := tv.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// orderedObject is a json object that keeps the order of its keys, so that
+// the migrated config files are written with the keys in the same order
+type orderedObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// decodeOrdered decodes the next json value from d, objects are decoded as an
+// *orderedObject, arrays as []interface{}, and the other values by d
+func decodeOrdered(d *json.Decoder) (interface{}, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case json.Delim('{'):
+		obj := &orderedObject{values: map[string]interface{}{}}
+		for d.More() {
+			kt, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			k := kt.(string)
+			v, err := decodeOrdered(d)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := obj.values[k]; !exists {
+				obj.keys = append(obj.keys, k)
+			}
+			obj.values[k] = v
+		}
+		_, err = d.Token()
+		return obj, err
+	case json.Delim('['):
+		arr := []interface{}{}
+		for d.More() {
+			v, err := decodeOrdered(d)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		_, err = d.Token()
+		return arr, err
+	}
+	return t, nil
+}
+
+// key returns the key in the object that matches k, keys are matched case
+// insensitively, the same as when they're decoded into a struct
+func (o *orderedObject) key(k string) (string, bool) {
+	if _, ok := o.values[k]; ok {
+		return k, true
+	}
+	for _, ok := range o.keys {
+		if strings.EqualFold(ok, k) {
+			return ok, true
+		}
+	}
+	return "", false
+}
+
+// remove removes the key k
+func (o *orderedObject) remove(k string) {
+	delete(o.values, k)
+	for i := range o.keys {
+		if o.keys[i] == k {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			return
+		}
+	}
+}
+
+// rename changes the key k to the key to, keeping its position in the object,
+// the key isn't changed if the object already has the key to
+func (o *orderedObject) rename(k, to string) {
+	from, ok := o.key(k)
+	if !ok {
+		return
+	}
+	if _, exists := o.key(to); exists {
+		if !strings.EqualFold(from, to) {
+			o.remove(from)
+		}
+		return
+	}
+	for i := range o.keys {
+		if o.keys[i] == from {
+			o.keys[i] = to
+		}
+	}
+	o.values[to] = o.values[from]
+	delete(o.values, from)
+}
+
+// take removes the value at the path from the object, and returns it
+func (o *orderedObject) take(path []string) (interface{}, bool) {
+	k, ok := o.key(path[0])
+	if !ok {
+		return nil, false
+	}
+	if len(path) > 1 {
+		child, ok := o.values[k].(*orderedObject)
+		if !ok {
+			return nil, false
+		}
+		return child.take(path[1:])
+	}
+	v := o.values[k]
+	o.remove(k)
+	return v, true
+}
+
+// put sets the value at the path, the objects along the path are created if they
+// don't exist, an existing value isn't replaced
+func (o *orderedObject) put(path []string, v interface{}) {
+	k, ok := o.key(path[0])
+	if len(path) == 1 {
+		if !ok {
+			o.keys = append(o.keys, path[0])
+			o.values[path[0]] = v
+		}
+		return
+	}
+	if !ok {
+		k = path[0]
+		o.keys = append(o.keys, k)
+		o.values[k] = &orderedObject{values: map[string]interface{}{}}
+	}
+	if child, ok := o.values[k].(*orderedObject); ok {
+		child.put(path[1:], v)
+	}
+}
+
+// orderedObjects returns the objects at the path in v, the elements of the arrays
+// along the path are all included
+func orderedObjects(v interface{}, path []string) []*orderedObject {
+	switch tv := v.(type) {
+	case *orderedObject:
+		if len(path) == 0 {
+			return []*orderedObject{tv}
+		}
+		if k, ok := tv.key(path[0]); ok {
+			return orderedObjects(tv.values[k], path[1:])
+		}
+	case []interface{}:
+		var res []*orderedObject
+		for _, e := range tv {
+			res = append(res, orderedObjects(e, path)...)
+		}
+		return res
+	}
+	return nil
+}
+
+// writeOrdered writes the value v as indented json
+func writeOrdered(w *bytes.Buffer, v interface{}, indent string) {
+	switch tv := v.(type) {
+	case *orderedObject:
+		tv.write(w, indent)
+	case []interface{}:
+		if len(tv) == 0 {
+			w.WriteString("[]")
+			return
+		}
+		w.WriteString("[\n")
+		for i, e := range tv {
+			w.WriteString(indent + "  ")
+			writeOrdered(w, e, indent+"  ")
+			if i < len(tv)-1 {
+				w.WriteByte(',')
+			}
+			w.WriteByte('\n')
+		}
+		w.WriteString(indent + "]")
+	default:
+		b, _ := json.Marshal(v)
+		w.Write(b)
+	}
+}
+
+// write writes the object as indented json, with the keys in order
+func (o *orderedObject) write(w *bytes.Buffer, indent string) {
+	if len(o.keys) == 0 {
+		w.WriteString("{}")
+		return
+	}
+	w.WriteString("{\n")
+	for i, k := range o.keys {
+		kb, _ := json.Marshal(k)
+		w.WriteString(indent + "  ")
+		w.Write(kb)
+		w.WriteString(": ")
+		writeOrdered(w, o.values[k], indent+"  ")
+		if i < len(o.keys)-1 {
+			w.WriteByte(',')
+		}
+		w.WriteByte('\n')
+	}
+	w.WriteString(indent + "}")
+}
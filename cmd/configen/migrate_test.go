@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MigrationEntries(t *testing.T) {
+	def, err := loadConfig("testdata/gen_migrate.json")
+	require.NoError(t, err)
+	assert.Equal(t, 3, def.SchemaVersion)
+	assert.Equal(t, []string{
+		`{version: 2, op: "move", path: []string{"Address"}, to: []string{"BindAddr"}}`,
+		`{version: 2, op: "move", path: []string{"CertFile"}, to: []string{"TLS", "CertFile"}}`,
+		`{version: 2, op: "move", path: []string{"Peers", "Addr"}, to: []string{"Peers", "URL"}, shared: 1}`,
+		`{version: 3, op: "convert", path: []string{"Timeout"}, conversion: "seconds"}`,
+		`{version: 3, op: "convert", path: []string{"Port"}, conversion: "number"}`,
+		`{version: 3, op: "remove", path: []string{"Debug"}}`,
+	}, def.MigrationEntries())
+}
+
+func Test_InvalidMigrations(t *testing.T) {
+	_, err := loadConfig("testdata/invalid_migrate_test.json")
+	require.Error(t, err)
+	errs, ok := errors.Cause(err).(definitionErrors)
+	require.True(t, ok, "%T", errors.Cause(err))
+	require.Len(t, errs, 8)
+	assert.Equal(t, "testdata/invalid_migrate_test.json:3:23: SchemaVersion: the field Version of Configuration has the key Version, which can't be used with a SchemaVersion", errs[0].Error())
+	assert.Equal(t, "testdata/invalid_migrate_test.json:5:23: Migrations[0].Version: migration version 3 isn't valid, it should be from 2 to the SchemaVersion 2", errs[1].Error())
+	assert.Equal(t, "testdata/invalid_migrate_test.json:6:23: Migrations[1].Version: migration version 2 is before the version 3 of the previous migration, they should be in version order", errs[2].Error())
+	assert.Equal(t, "testdata/invalid_migrate_test.json:6:76: Migrations[1].To: the new key \"HTTP.BindAddr\" of a rename isn't valid, use a move to change the path", errs[3].Error())
+	assert.Equal(t, "testdata/invalid_migrate_test.json:7:76: Migrations[2].To: path \"TLS..CertFile\" isn't valid, it should be the json keys of the value separated by .", errs[4].Error())
+	assert.Equal(t, "testdata/invalid_migrate_test.json:8:84: Migrations[3].Conversion: conversion \"hours\" isn't valid, it should be one of seconds, milliseconds, string, number", errs[5].Error())
+	assert.Equal(t, "testdata/invalid_migrate_test.json:9:33: Migrations[4].Op: migration op \"copy\" isn't valid, it should be one of rename, move, convert, remove", errs[6].Error())
+	assert.Equal(t, "testdata/invalid_migrate_test.json:9:53: Migrations[4].Path: path \".Port\" isn't valid, it should be the json keys of the value separated by .", errs[7].Error())
+}
+
+func Test_MigrateFile(t *testing.T) {
+	def, err := loadConfig("testdata/gen_migrate.json")
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "configen-migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, err := ioutil.ReadFile("testdata/migrate_config.json")
+	require.NoError(t, err)
+	fn := filepath.Join(dir, "config.json")
+	require.NoError(t, ioutil.WriteFile(fn, src, 0640))
+
+	var out bytes.Buffer
+	require.NoError(t, def.migrateFile(fn, &out))
+	assert.Equal(t, fn+": migrated from version 1 to 3\n", out.String())
+
+	migrated, err := ioutil.ReadFile(fn)
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "version": 3,
+  "Defaults": {
+    "BindAddr": ":8080",
+    "Port": 8080,
+    "Timeout": "30s",
+    "TLS": {
+      "KeyFile": "/etc/service/key.pem",
+      "CertFile": "/etc/service/cert.pem"
+    },
+    "Peers": [
+      {
+        "URL": "https://peer1:8443",
+        "Tags": [
+          "eu"
+        ]
+      },
+      {
+        "URL": "https://peer2:8443"
+      }
+    ]
+  },
+  "Hosts": {
+    "node1": "prod"
+  },
+  "Overrides": {
+    "prod": {
+      "Timeout": "1m30s"
+    }
+  }
+}
+`, string(migrated))
+	st, err := os.Stat(fn)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), st.Mode().Perm())
+
+	out.Reset()
+	require.NoError(t, def.migrateFile(fn, &out))
+	assert.Equal(t, fn+": is already at version 3\n", out.String())
+
+	// files without Defaults are migrated as override files
+	ov := filepath.Join(dir, "override.json")
+	require.NoError(t, ioutil.WriteFile(ov, []byte(`{"version": 2, "Timeout": 0.5, "Debug": false}`), 0600))
+	out.Reset()
+	require.NoError(t, def.migrateFile(ov, &out))
+	assert.Equal(t, ov+": migrated from version 2 to 3\n", out.String())
+	migrated, err = ioutil.ReadFile(ov)
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"version\": 3,\n  \"Timeout\": \"500ms\"\n}\n", string(migrated))
+
+	require.NoError(t, ioutil.WriteFile(ov, []byte(`{"version": 4}`), 0600))
+	err = def.migrateFile(ov, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the config file has version 4, which is newer than the schema version 3")
+
+	require.NoError(t, ioutil.WriteFile(ov, []byte(`{"Port": "http"}`), 0600))
+	err = def.migrateFile(ov, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Port: "http" isn't a number`)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// this file contains the generation of mock_config.go, which has a fake
+// implementation of each of the <Name>Config getter interfaces, with settable
+// return values and call counting, for unit testing the code that uses them.
+
+// generateMocks will load the config definition file, and write the
+// mock_config.go & mock_config_test.go files in the supplied directory.
+func generateMocks(defFile, destDir string) error {
+	def, err := loadConfig(defFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(def.writeMocks(destDir))
+}
+
+// writeMocks writes the mocks of the getter interfaces for the loaded definition
+// to the supplied directory, it's an error if none of the types are WithGetter
+func (def *templateData) writeMocks(destDir string) error {
+	if !def.HasGetters() {
+		return errors.New("none of the types in the definition are WithGetter, there are no interfaces to mock")
+	}
+	if err := def.setDefaultPackageName(destDir); err != nil {
+		return errors.Trace(err)
+	}
+	t, err := loadTemplates()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for destFilename, templateName := range map[string]string{
+		"mock_config.go":      "/mock_config.go.template",
+		"mock_config_test.go": "/mock_config_test.go.template",
+	} {
+		destFile := filepath.Join(destDir, destFilename)
+		df, err := os.Create(destFile)
+		if err != nil {
+			return errors.Errorf("unable to create destination file: %v", err)
+		}
+		err = t.ExecuteTemplate(df, templateName, def)
+		df.Close()
+		if err != nil {
+			return errors.Errorf("unable to generate code: %v", err)
+		}
+		gofmt(destFile)
+	}
+	return nil
+}
+
+// HasGetters returns true if any of the types are WithGetter
+func (td *templateData) HasGetters() bool {
+	for _, s := range td.Structs {
+		if s.WithGetter {
+			return true
+		}
+	}
+	return false
+}
+
+// MockImports pipe returns the packages used by the getters, which need to be
+// imported by mock_config.go
+func (td *templateData) MockImports() []string {
+	imports := map[string]bool{}
+	for _, s := range td.Structs {
+		if !s.WithGetter {
+			continue
+		}
+		for _, f := range s.Fields {
+			if f.IsDuration() {
+				imports["time"] = true
+			} else if f.GoType.external != nil {
+				imports[f.GoType.external.Import] = true
+			}
+		}
+	}
+	res := make([]string, 0, len(imports))
+	for imp := range imports {
+		res = append(res, imp)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// GetterName pipe returns the name of the getter method for the field
+func (f *fieldInfo) GetterName() string {
+	mn, _ := f.getter()
+	return mn
+}
+
+// MockImpl pipe returns the Mock<Name>Config type, which implements the <Name>Config
+// interface, each getter returns the value of the mock field of the same name
+// without the Get prefix, e.g. GetHTTPCfg returns HTTPCfg
+func (s *structInfo) MockImpl() string {
+	n := s.GoType.Name
+	intName, mockName := n+"Config", "Mock"+n+"Config"
+	getters := s.GetterFields()
+	fields := make([]string, 0, len(getters))
+	inits := make([]string, 0, len(getters))
+	methods := make([]string, 0, len(getters))
+	for _, f := range getters {
+		mn, rt := f.getter()
+		fn := strings.TrimPrefix(mn, "Get")
+		fields = append(fields, fmt.Sprintf("\t// %s is returned by %s\n\t%s %s", fn, mn, fn, rt))
+		inits = append(inits, fmt.Sprintf("\t\t%s: c.%s(),", fn, mn))
+		methods = append(methods, fmt.Sprintf(`// %[1]s returns the %[2]s field of the mock
+func (m *%[3]s) %[1]s() %[4]s {
+	m.called(%[1]q)
+	return m.%[2]s
+}`, mn, fn, mockName, rt))
+	}
+	return fmt.Sprintf(`// %[1]s is a fake implementation of %[2]s, the getters return the values of
+// the fields, and the number of calls to each getter is returned by Calls
+type %[1]s struct {
+%[3]s
+	mockCalls
+}
+
+// NewMock%[4]sConfig returns a %[1]s that returns the values from c
+func NewMock%[4]sConfig(c *%[4]s) *%[1]s {
+	return &%[1]s{
+%[5]s
+	}
+}
+
+%[6]s`, mockName, intName, strings.Join(fields, "\n"), n, strings.Join(inits, "\n"), strings.Join(methods, "\n\n"))
+}
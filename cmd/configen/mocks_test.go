@@ -0,0 +1,43 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MockImpl(t *testing.T) {
+	def, err := loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	assert.True(t, def.HasGetters())
+	assert.Equal(t, []string{"time"}, def.MockImports())
+
+	impl := def.Structs["Configuration"].MockImpl()
+	assert.Contains(t, impl, "type MockConfigurationConfig struct {\n")
+	assert.Contains(t, impl, "\t// EtcdCfg is returned by GetEtcdCfg\n\tEtcdCfg *Etcd\n")
+	assert.Contains(t, impl, "\t\tEtcdCfg: c.GetEtcdCfg(),\n")
+	assert.Contains(t, impl, "func (m *MockConfigurationConfig) GetEtcdCfg() *Etcd {\n\tm.called(\"GetEtcdCfg\")\n\treturn m.EtcdCfg\n}")
+
+	def, err = loadConfig("testdata/gen_external.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"net", "net/url", "time"}, def.MockImports())
+}
+
+func Test_WriteMocksNoGetters(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mocks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	def, err := loadConfig("testdata/gen_slices.json")
+	require.NoError(t, err)
+	require.False(t, def.HasGetters())
+	err = def.writeMocks(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "there are no interfaces to mock")
+	_, err = os.Stat(filepath.Join(dir, "mock_config.go"))
+	assert.True(t, os.IsNotExist(err))
+}
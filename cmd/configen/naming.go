@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// this file contains the support for the json keys of the fields, these are
+// derived from the field names by the Naming policy of the definition, or set
+// per field with "json", and the struct tags that are generated for them.
+
+// the naming policies that can be used for the Naming of the definition
+const (
+	namingGo    = "go"
+	namingCamel = "camelCase"
+	namingSnake = "snake_case"
+	namingKebab = "kebab-case"
+)
+
+// namingPolicies are the valid values of the Naming of the definition
+var namingPolicies = []string{namingGo, namingCamel, namingSnake, namingKebab}
+
+// omitEmptyTags are the tags that support the omitempty option
+var omitEmptyTags = map[string]bool{"json": true, "yaml": true, "toml": true}
+
+// validNaming returns true if naming is one of the namingPolicies, or isn't set
+func validNaming(naming string) bool {
+	valid := naming == ""
+	for _, n := range namingPolicies {
+		valid = valid || naming == n
+	}
+	return valid
+}
+
+// fieldKey returns the key of the field in the config file for the naming policy
+func fieldKey(name, naming string) string {
+	switch naming {
+	case namingCamel:
+		runes := []rune(name)
+		i := 0
+		for i < len(runes) && unicode.IsUpper(runes[i]) {
+			i++
+		}
+		// the last upper case letter of an initialism starts the next word, e.g. HTTPServer -> httpServer
+		if i > 1 && i < len(runes) {
+			i--
+		}
+		return strings.ToLower(string(runes[:i])) + string(runes[i:])
+	case namingSnake:
+		return strings.Join(splitWords(name), "_")
+	case namingKebab:
+		return strings.Join(splitWords(name), "-")
+	}
+	return name
+}
+
+// Key pipe returns the key of the field in the config file
+func (f *fieldInfo) Key() string {
+	if f.jsonKey != "" {
+		return f.jsonKey
+	}
+	return f.Name
+}
+
+// Tag pipe returns the struct tag of the field, including the leading space, or
+// an empty string if the field doesn't need one
+func (f *fieldInfo) Tag() string {
+	if len(f.tags) == 0 {
+		return ""
+	}
+	return " `" + strings.Join(f.tags, " ") + "`"
+}
+
+// tagValue returns the value of the tag for the field, e.g. bind_addr,omitempty
+func (f *fieldInfo) tagValue(tag string) string {
+	v, exists := f.Tags[tag]
+	switch {
+	case exists:
+		if v == "-" {
+			return v
+		}
+	case tag == "env":
+		v = strings.ToUpper(strings.Join(splitWords(f.Name), "_"))
+	default:
+		v = f.Key()
+	}
+	if f.OmitEmpty && omitEmptyTags[tag] {
+		v += ",omitempty"
+	}
+	return v
+}
+
+// applyNaming sets the json keys and struct tags of the fields of all the structs,
+// it returns errors for invalid keys, and for keys that are used by more than one
+// field of a struct, including the fields promoted from embedded types
+func (def *configDef) applyNaming(structs map[string]*structInfo) definitionErrors {
+	if !validNaming(def.Naming) {
+		return definitionErrors{def.errorf("Naming", "naming policy %q isn't valid, it should be one of %s",
+			def.Naming, strings.Join(namingPolicies, ", "))}
+	}
+	var errs definitionErrors
+	for idx, t := range def.Tags {
+		if t == "json" || t == "" || strings.ContainsAny(t, " :\"`") {
+			errs = append(errs, def.errorf(fmt.Sprintf("Tags[%d]", idx), "tag %q isn't valid, it should be the name of a struct tag other than json", t))
+		}
+	}
+	for tn, s := range structs {
+		for idx := range s.Fields {
+			f := &s.Fields[idx]
+			if f.embedded {
+				continue
+			}
+			switch {
+			case f.JSON == "":
+				f.jsonKey = fieldKey(f.Name, def.Naming)
+			case f.JSON == "-" || strings.ContainsAny(f.JSON, ",\"`"):
+				errs = append(errs, s.errorf(tn, s.fieldPath(idx, "json"), "json key %q of field %s of %s isn't valid, it can't be - or contain a comma, quote or backtick", f.JSON, f.Name, tn))
+			default:
+				f.jsonKey = f.JSON
+			}
+			f.tags = f.structTags(def.Tags)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	for tn, s := range structs {
+		errs = append(errs, s.validateKeys(tn)...)
+	}
+	return errs
+}
+
+// structTags returns the struct tags for the field, the json tag is only included
+// if the key isn't the field name, or it has options
+func (f *fieldInfo) structTags(defTags []string) []string {
+	names := append([]string{}, defTags...)
+	extra := make([]string, 0, len(f.Tags))
+	for t := range f.Tags {
+		found := false
+		for _, n := range defTags {
+			found = found || n == t
+		}
+		if !found {
+			extra = append(extra, t)
+		}
+	}
+	sort.Strings(extra)
+	names = append(names, extra...)
+
+	var tags []string
+	if f.Key() != f.Name || f.OmitEmpty {
+		tags = append(tags, fmt.Sprintf("json:%q", f.tagValue("json")))
+	}
+	for _, t := range names {
+		tags = append(tags, fmt.Sprintf("%s:%q", t, f.tagValue(t)))
+	}
+	return tags
+}
+
+// promotedKeys returns the json key and name of each of the fields of the struct,
+// including the fields promoted from the embedded types
+func (s *structInfo) promotedKeys() [][2]string {
+	res := make([][2]string, 0, len(s.Fields))
+	for idx := range s.Fields {
+		f := &s.Fields[idx]
+		if f.embedded {
+			res = append(res, f.GoType.structDef.promotedKeys()...)
+			continue
+		}
+		res = append(res, [2]string{f.Key(), f.Name})
+	}
+	return res
+}
+
+// validateKeys returns errors for fields with a json key that's also used by another
+// field, keys are compared case insensitively as that's how they are decoded
+func (s *structInfo) validateKeys(name string) definitionErrors {
+	var errs definitionErrors
+	keys := map[string]string{}
+	for idx := range s.Fields {
+		f := &s.Fields[idx]
+		fields, member := [][2]string{{f.Key(), f.Name}}, "name"
+		if f.embedded {
+			fields = f.GoType.structDef.promotedKeys()
+		} else if f.JSON != "" {
+			member = "json"
+		}
+		for _, kn := range fields {
+			k := strings.ToLower(kn[0])
+			// fields with the same name are reported by the lint command
+			if other, exists := keys[k]; exists && other != kn[1] {
+				errs = append(errs, s.errorf(name, s.fieldPath(idx, member),
+					"field %s of %s has the json key %s, which is also used by the field %s", kn[1], name, kn[0], other))
+				continue
+			}
+			keys[k] = kn[1]
+		}
+	}
+	return errs
+}
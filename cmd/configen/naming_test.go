@@ -0,0 +1,79 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FieldKey(t *testing.T) {
+	tcs := []struct {
+		name, naming, exp string
+	}{
+		{"BindAddr", "", "BindAddr"},
+		{"BindAddr", namingGo, "BindAddr"},
+		{"BindAddr", namingCamel, "bindAddr"},
+		{"HTTPServer", namingCamel, "httpServer"},
+		{"URL", namingCamel, "url"},
+		{"ServerTLS", namingCamel, "serverTLS"},
+		{"HTTPServer", namingSnake, "http_server"},
+		{"MaxSizeMb", namingSnake, "max_size_mb"},
+		{"HTTPServer", namingKebab, "http-server"},
+		{"ServerTLS", namingKebab, "server-tls"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.exp, fieldKey(tc.name, tc.naming), "%s with %s", tc.name, tc.naming)
+	}
+}
+
+func Test_Naming(t *testing.T) {
+	def, err := loadConfig("testdata/gen_naming.json")
+	require.NoError(t, err)
+
+	c := def.Structs["Configuration"]
+	assert.Equal(t, " `json:\"service_name\" yaml:\"service_name\" env:\"SERVICE_NAME\"`", c.Fields[0].Tag())
+	assert.Equal(t, " `json:\"http\" yaml:\"http\" env:\"HTTP_SERVER\"`", c.Fields[1].Tag())
+	assert.Equal(t, " `json:\"debug,omitempty\" yaml:\"debug,omitempty\" env:\"SERVICE_DEBUG\"`", c.Fields[3].Tag())
+	assert.Equal(t, "", def.Structs["Listener"].Fields[0].Tag(), "embedded fields don't have tags")
+	tls := def.Structs["TLSInfo"]
+	assert.Equal(t, " `json:\"key_file\" yaml:\"-\" env:\"KEY_FILE\" toml:\"key\"`", tls.Fields[1].Tag())
+	assert.Equal(t, `{"bind_addr":":8443","read_timeout":"10s"}`, def.Structs["Listener"].DefaultsJSON())
+
+	// there are no tags with the go naming
+	def, err = loadConfig("testdata/gen_def.json")
+	require.NoError(t, err)
+	for _, s := range def.Structs {
+		for _, f := range s.Fields {
+			assert.Equal(t, "", f.Tag())
+		}
+	}
+
+	cfg, err := readDefFile("testdata/gen_naming.json")
+	require.NoError(t, err)
+	cfg.Naming = "PascalCase"
+	_, err = cfg.processConfig()
+	require.Error(t, err)
+	assert.Equal(t, "testdata/gen_naming.json:3:16: Naming: naming policy \"PascalCase\" isn't valid, it should be one of go, camelCase, snake_case, kebab-case", err.Error())
+}
+
+func Test_InvalidNaming(t *testing.T) {
+	errsOf := func(fn string) definitionErrors {
+		_, err := loadConfig(fn)
+		require.Error(t, err)
+		errs, ok := errors.Cause(err).(definitionErrors)
+		require.True(t, ok, "%T", errors.Cause(err))
+		return errs
+	}
+	errs := errsOf("testdata/invalid_naming_test.json")
+	require.Len(t, errs, 3)
+	assert.Equal(t, "testdata/invalid_naming_test.json:3:24: Tags[1]: tag \"json\" isn't valid, it should be the name of a struct tag other than json", errs[0].Error())
+	assert.Equal(t, "testdata/invalid_naming_test.json:7:64: Configuration.Fields[0].json: json key \"bind,omitempty\" of field BindAddr of Configuration isn't valid, it can't be - or contain a comma, quote or backtick", errs[1].Error())
+	assert.Contains(t, errs[2].Error(), "Configuration.Fields[1].json: json key \"-\" of field Name")
+
+	errs = errsOf("testdata/naming_clash_test.json")
+	require.Len(t, errs, 2)
+	assert.Equal(t, "testdata/naming_clash_test.json:8:62: Configuration.Fields[1].json: field Listen of Configuration has the json key Bind_Addr, which is also used by the field BindAddr", errs[0].Error())
+	assert.Equal(t, "testdata/naming_clash_test.json:9:60: Configuration.Fields[2].json: field Cert of Configuration has the json key cert_file, which is also used by the field CertFile", errs[1].Error())
+}
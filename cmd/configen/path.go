@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// this file contains the template pipes used to generate the GetPath, SetPath
+// and Walk methods, these access a value by its path, e.g. HTTP.ServerTLS.CertFile
+// or LogLevels[0].Level, the values of maps are accessed by their key, e.g.
+// Backends.api.URL, the generated code is a switch on the field names, it doesn't
+// use reflection
+
+// pathKind describes how a field is accessed by path
+type pathKind int
+
+const (
+	// pkValue is a field with no nested values
+	pkValue pathKind = iota
+	// pkSlice is a slice of one of the std types, elements can be accessed by index
+	pkSlice
+	// pkStruct is a related type, the path can continue into its fields
+	pkStruct
+	// pkStructSlice is a slice of a related type, the path can continue into the fields of an element
+	pkStructSlice
+	// pkStructMap is a map of a related type, the path can continue into the fields of a value by its key
+	pkStructMap
+	// pkStructPtr is a pointer to a related type, the path can continue into its fields
+	pkStructPtr
+)
+
+func (f *fieldInfo) pathKind() pathKind {
+	switch {
+	case f.IsStruct():
+		return pkStruct
+	case f.GoType.external != nil:
+		return pkValue
+	case f.GoType.IsSlice() && f.GoType.structDef != nil:
+		return pkStructSlice
+	case f.GoType.IsMap():
+		return pkStructMap
+	case f.GoType.IsPointer() && f.GoType.structDef != nil:
+		return pkStructPtr
+	case f.GoType.IsSlice():
+		return pkSlice
+	}
+	return pkValue
+}
+
+// parseFuncs contains the expression that parses the string variable value for each of the std scalar types
+var parseFuncs = map[string]string{
+	"bool":     "strconv.ParseBool(value)",
+	"*bool":    "strconv.ParseBool(value)",
+	"int":      "strconv.Atoi(value)",
+	"int64":    "strconv.ParseInt(value, 10, 64)",
+	"uint64":   "strconv.ParseUint(value, 10, 64)",
+	"float64":  "strconv.ParseFloat(value, 64)",
+	"Duration": "parseDuration(value)",
+}
+
+// parseImpl returns the statements that parse value as the type typ, assign it to dest and return
+func parseImpl(typ, dest string) string {
+	if typ == "string" {
+		return fmt.Sprintf("%s = value\nreturn nil", dest)
+	}
+	assign := "v"
+	if typ == "*bool" {
+		assign = "&v"
+	}
+	return fmt.Sprintf("v, err := %s\nif err != nil {\n\treturn valueError(full, value, err)\n}\n%s = %s\nreturn nil",
+		parseFuncs[typ], dest, assign)
+}
+
+// GetPathImpl pipe returns the case of the getPath switch for this field
+func (f *fieldInfo) GetPathImpl() string {
+	r := &strings.Builder{}
+	fv := "c." + f.Name
+	if f.embedded {
+		return f.promotedCase(fmt.Sprintf("return %s.getPath(full, path)", fv))
+	}
+	fmt.Fprintf(r, "case %q:\n", f.Name)
+	switch f.pathKind() {
+	case pkStruct:
+		fmt.Fprintf(r, "if idx >= 0 {\n\treturn nil, notSliceError(full, %q)\n}\n", f.Name)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn %s, nil\n}\n", fv)
+		fmt.Fprintf(r, "return %s.getPath(full, rest)", fv)
+	case pkStructSlice:
+		fmt.Fprintf(r, "if idx < 0 {\n\tif rest != \"\" {\n\t\treturn nil, indexRequiredError(full, %q)\n\t}\n\treturn %s, nil\n}\n", f.Name, fv)
+		fmt.Fprintf(r, "if idx >= len(%s) {\n\treturn nil, indexError(full, idx, len(%s))\n}\n", fv, fv)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn %s[idx], nil\n}\n", fv)
+		fmt.Fprintf(r, "return %s[idx].getPath(full, rest)", fv)
+	case pkStructMap:
+		fmt.Fprintf(r, "if idx >= 0 {\n\treturn nil, notSliceError(full, %q)\n}\n", f.Name)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn %s, nil\n}\n", fv)
+		r.WriteString("key, tail := splitKey(rest)\n")
+		fmt.Fprintf(r, "v, exists := %s[key]\nif !exists {\n\treturn nil, missingError(full, %q+key)\n}\n", fv, f.Name+".")
+		r.WriteString("if tail == \"\" {\n\treturn v, nil\n}\n")
+		r.WriteString("return v.getPath(full, tail)")
+	case pkStructPtr:
+		fmt.Fprintf(r, "if idx >= 0 {\n\treturn nil, notSliceError(full, %q)\n}\n", f.Name)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn %s, nil\n}\n", fv)
+		fmt.Fprintf(r, "if %s == nil {\n\treturn nil, missingError(full, %q)\n}\n", fv, f.Name)
+		fmt.Fprintf(r, "return %s.getPath(full, rest)", fv)
+	case pkSlice:
+		r.WriteString("if rest != \"\" {\n\treturn nil, leafError(full)\n}\n")
+		fmt.Fprintf(r, "if idx < 0 {\n\treturn %s, nil\n}\n", fv)
+		fmt.Fprintf(r, "if idx >= len(%s) {\n\treturn nil, indexError(full, idx, len(%s))\n}\n", fv, fv)
+		fmt.Fprintf(r, "return %s[idx], nil", fv)
+	default:
+		r.WriteString("if idx >= 0 || rest != \"\" {\n\treturn nil, leafError(full)\n}\n")
+		if f.IsBoolPtr() {
+			fv = "boolValue(" + fv + ")"
+		}
+		fmt.Fprintf(r, "return %s, nil", fv)
+	}
+	return r.String()
+}
+
+// SetPathImpl pipe returns the case of the setPath switch for this field
+func (f *fieldInfo) SetPathImpl() string {
+	r := &strings.Builder{}
+	fv := "c." + f.Name
+	if f.embedded {
+		return f.promotedCase(fmt.Sprintf("return %s.setPath(full, path, value)", fv))
+	}
+	fmt.Fprintf(r, "case %q:\n", f.Name)
+	switch f.pathKind() {
+	case pkStruct:
+		fmt.Fprintf(r, "if idx >= 0 {\n\treturn notSliceError(full, %q)\n}\n", f.Name)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn setJSON(full, value, &%s)\n}\n", fv)
+		fmt.Fprintf(r, "return %s.setPath(full, rest, value)", fv)
+	case pkStructSlice:
+		fmt.Fprintf(r, "if idx < 0 {\n\tif rest != \"\" {\n\t\treturn indexRequiredError(full, %q)\n\t}\n\treturn setJSON(full, value, &%s)\n}\n", f.Name, fv)
+		fmt.Fprintf(r, "if idx >= len(%s) {\n\treturn indexError(full, idx, len(%s))\n}\n", fv, fv)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn setJSON(full, value, &%s[idx])\n}\n", fv)
+		fmt.Fprintf(r, "return %s[idx].setPath(full, rest, value)", fv)
+	case pkStructMap:
+		// the value is copied out of the map, and stored once it's been set
+		fmt.Fprintf(r, "if idx >= 0 {\n\treturn notSliceError(full, %q)\n}\n", f.Name)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn setJSON(full, value, &%s)\n}\n", fv)
+		fmt.Fprintf(r, "key, tail := splitKey(rest)\nv := %s[key]\n", fv)
+		r.WriteString("if tail == \"\" {\n\terr = setJSON(full, value, &v)\n} else {\n\terr = v.setPath(full, tail, value)\n}\n")
+		r.WriteString("if err != nil {\n\treturn err\n}\n")
+		fmt.Fprintf(r, "if %s == nil {\n\t%s = %s{}\n}\n", fv, fv, f.GoType.Name)
+		fmt.Fprintf(r, "%s[key] = v\nreturn nil", fv)
+	case pkStructPtr:
+		fmt.Fprintf(r, "if idx >= 0 {\n\treturn notSliceError(full, %q)\n}\n", f.Name)
+		fmt.Fprintf(r, "if rest == \"\" {\n\treturn setJSON(full, value, &%s)\n}\n", fv)
+		fmt.Fprintf(r, "if %s == nil {\n\t%s = new(%s)\n}\n", fv, fv, f.GoType.structDef.GoType.Name)
+		fmt.Fprintf(r, "return %s.setPath(full, rest, value)", fv)
+	case pkSlice:
+		elem := f.GoType.Name[2:]
+		r.WriteString("if rest != \"\" {\n\treturn leafError(full)\n}\n")
+		fmt.Fprintf(r, "if idx < 0 {\n\treturn setSlice(full, value, %v, &%s)\n}\n", elem == "string", fv)
+		fmt.Fprintf(r, "if idx >= len(%s) {\n\treturn indexError(full, idx, len(%s))\n}\n", fv, fv)
+		r.WriteString(parseImpl(elem, fv+"[idx]"))
+	default:
+		r.WriteString("if idx >= 0 || rest != \"\" {\n\treturn leafError(full)\n}\n")
+		if f.GoType.external != nil {
+			fmt.Fprintf(r, "return setJSON(full, value, &%s)", fv)
+		} else {
+			r.WriteString(parseImpl(f.GoType.Name, fv))
+		}
+	}
+	return r.String()
+}
+
+// promotedCase returns the case of the getPath or setPath switch for the fields that
+// are promoted from an embedded type, the entire path is resolved by the embedded type
+func (f *fieldInfo) promotedCase(stmt string) string {
+	names := f.GoType.structDef.promotedNames()
+	if len(names) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = fmt.Sprintf("%q", n)
+	}
+	return fmt.Sprintf("case %s:\n%s", strings.Join(quoted, ", "), stmt)
+}
+
+// WalkImpl pipe returns the statement that walks this field
+func (f *fieldInfo) WalkImpl() string {
+	fv := "c." + f.Name
+	if f.embedded {
+		return fmt.Sprintf("%s.walk(prefix, fn)", fv)
+	}
+	switch f.pathKind() {
+	case pkStruct:
+		return fmt.Sprintf("%s.walk(prefix+\"%s.\", fn)", fv, f.Name)
+	case pkStructSlice:
+		return fmt.Sprintf("for i := range %s {\n\t%s[i].walk(fmt.Sprintf(\"%%s%s[%%d].\", prefix, i), fn)\n}", fv, fv, f.Name)
+	case pkStructMap:
+		// the values are walked in the order of their keys
+		return fmt.Sprintf("for _, k := range %s(%s) {\n\tv := %s[k]\n\tv.walk(prefix+%q+k+\".\", fn)\n}", f.GoType.KeysFunc(), fv, fv, f.Name+".")
+	case pkStructPtr:
+		return fmt.Sprintf("if %s != nil {\n\t%s.walk(prefix+\"%s.\", fn)\n}", fv, fv, f.Name)
+	}
+	if f.IsBoolPtr() {
+		fv = "boolValue(" + fv + ")"
+	}
+	return fmt.Sprintf("fn(prefix+%q, %s)", f.Name, fv)
+}
+
+// IsIndexable returns true if the elements of the field can be accessed by index in a path
+func (f *fieldInfo) IsIndexable() bool {
+	k := f.pathKind()
+	return k == pkSlice || k == pkStructSlice
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PathKind(t *testing.T) {
+	def, err := loadConfig("testdata/gen_slices.json")
+	require.NoError(t, err)
+	exp := map[string]pathKind{
+		"Services": pkSlice,
+		"Ports":    pkSlice,
+		"Debug":    pkValue,
+		"Peers":    pkStructSlice,
+	}
+	for _, f := range def.Structs["Configuration"].Fields {
+		assert.Equal(t, exp[f.Name], f.pathKind(), "field %s", f.Name)
+		assert.Equal(t, exp[f.Name] != pkValue, f.IsIndexable(), "field %s", f.Name)
+	}
+
+	def, err = loadConfig("testdata/gen_external.json")
+	require.NoError(t, err)
+	for _, f := range def.Structs["Configuration"].Fields {
+		if f.GoType.external != nil {
+			assert.Equal(t, pkValue, f.pathKind(), "external field %s", f.Name)
+			assert.Contains(t, f.SetPathImpl(), "return setJSON(full, value, &c."+f.Name+")")
+		}
+	}
+}
+
+func Test_PathImpl(t *testing.T) {
+	def, err := loadConfig("testdata/gen_slices.json")
+	require.NoError(t, err)
+	fields := map[string]*fieldInfo{}
+	for idx, f := range def.Structs["Configuration"].Fields {
+		fields[f.Name] = &def.Structs["Configuration"].Fields[idx]
+	}
+
+	assert.Contains(t, fields["Debug"].GetPathImpl(), "return boolValue(c.Debug), nil")
+	assert.Contains(t, fields["Debug"].SetPathImpl(), "v, err := strconv.ParseBool(value)")
+	assert.Contains(t, fields["Debug"].SetPathImpl(), "c.Debug = &v")
+	assert.Equal(t, `fn(prefix+"Debug", boolValue(c.Debug))`, fields["Debug"].WalkImpl())
+
+	assert.Contains(t, fields["Ports"].SetPathImpl(), "return setSlice(full, value, false, &c.Ports)")
+	assert.Contains(t, fields["Ports"].SetPathImpl(), "v, err := strconv.Atoi(value)")
+	assert.Contains(t, fields["Services"].SetPathImpl(), "return setSlice(full, value, true, &c.Services)")
+	assert.Contains(t, fields["Services"].SetPathImpl(), "c.Services[idx] = value")
+
+	assert.Contains(t, fields["Peers"].GetPathImpl(), "return c.Peers[idx].getPath(full, rest)")
+	assert.Contains(t, fields["Peers"].SetPathImpl(), "return setJSON(full, value, &c.Peers[idx])")
+	assert.Contains(t, fields["Peers"].WalkImpl(), `c.Peers[i].walk(fmt.Sprintf("%sPeers[%d].", prefix, i), fn)`)
+}
+
+func Test_parseImpl(t *testing.T) {
+	assert.Equal(t, "c.Name = value\nreturn nil", parseImpl("string", "c.Name"))
+	assert.Contains(t, parseImpl("Duration", "c.Timeout"), "v, err := parseDuration(value)")
+	assert.Contains(t, parseImpl("uint64", "c.Size"), "strconv.ParseUint(value, 10, 64)")
+	for _, n := range stdTypeNames() {
+		if n[0] == '[' {
+			n = n[2:]
+		}
+		if n != "string" {
+			assert.Contains(t, parseFuncs, n, "parseFuncs is missing type %s", n)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// this file contains the tracking of the positions of the values in the
+// definition files, these are included in the errors found in a definition,
+// and in the diagnostics from the lint command.
+
+// definitionError is an error in a definition file, with the position of the value that caused it
+type definitionError struct {
+	File   string
+	Line   int
+	Column int
+	// Struct is the name of the struct the error was found in, if any
+	Struct string
+	// Path is the json path of the value in the definition file, e.g. RelatedTypes.Bob.Fields[0].type
+	Path string
+	Msg  string
+}
+
+func (e *definitionError) Error() string {
+	msg := e.Msg
+	if e.Path != "" {
+		msg = e.Path + ": " + e.Msg
+	}
+	if e.Line == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, msg)
+}
+
+// definitionErrors is all the errors found in a definition
+type definitionErrors []*definitionError
+
+func (e definitionErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// sort orders the errors by their position
+func (e definitionErrors) sort() {
+	sort.SliceStable(e, func(a, b int) bool {
+		if e[a].File != e[b].File {
+			return e[a].File < e[b].File
+		}
+		if e[a].Line != e[b].Line {
+			return e[a].Line < e[b].Line
+		}
+		return e[a].Column < e[b].Column
+	})
+}
+
+// newDefinitionError returns an error for the value with the json path in the file, index
+// is the index of the file, or nil if its not known [e.g. for types created in code]
+func newDefinitionError(file string, index *jsonIndex, structName, path, format string, args ...interface{}) *definitionError {
+	e := &definitionError{File: file, Struct: structName, Path: path, Msg: fmt.Sprintf(format, args...)}
+	if index != nil {
+		e.Line, e.Column = index.position(index.offset(strings.ToLower(path)))
+	}
+	return e
+}
+
+// jsonIndex maps the path of each value in a json document [e.g. relatedtypes.tlsinfo.fields[0].type]
+// to the offset of the value, the object keys are lower cased, as they are matched
+// case insensitively when the definition is decoded
+type jsonIndex struct {
+	data    []byte
+	offsets map[string]int
+	// duplicates contains the object members that are defined more than once
+	duplicates []jsonMember
+}
+
+// jsonMember is the path and key of a member of an object
+type jsonMember struct {
+	path string
+	key  string
+}
+
+// indexJSON builds the index for the json document
+func indexJSON(data []byte) (*jsonIndex, error) {
+	ix := &jsonIndex{data: data, offsets: map[string]int{}}
+	d := json.NewDecoder(bytes.NewReader(data))
+	if err := ix.value(d, ""); err != nil {
+		return nil, err
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, &json.SyntaxError{Offset: int64(ix.next(d))}
+	}
+	return ix, nil
+}
+
+func (ix *jsonIndex) value(d *json.Decoder, path string) error {
+	ix.offsets[path] = ix.next(d)
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch t {
+	case json.Delim('{'):
+		seen := map[string]bool{}
+		for d.More() {
+			k, err := d.Token()
+			if err != nil {
+				return err
+			}
+			key := fmt.Sprint(k)
+			p := strings.ToLower(key)
+			if path != "" {
+				p = path + "." + p
+			}
+			if seen[p] {
+				ix.duplicates = append(ix.duplicates, jsonMember{path: p, key: key})
+			}
+			seen[p] = true
+			if err = ix.value(d, p); err != nil {
+				return err
+			}
+		}
+		_, err = d.Token()
+	case json.Delim('['):
+		for i := 0; d.More(); i++ {
+			if err = ix.value(d, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		_, err = d.Token()
+	}
+	return err
+}
+
+// next returns the offset of the start of the next token
+func (ix *jsonIndex) next(d *json.Decoder) int {
+	i := int(d.InputOffset())
+	for i < len(ix.data) && strings.IndexByte(" \t\r\n,:", ix.data[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+// offset returns the offset of the value with the path, or of its closest parent
+func (ix *jsonIndex) offset(path string) int {
+	for {
+		if o, exists := ix.offsets[path]; exists {
+			return o
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			return ix.offsets[""]
+		}
+		path = path[:i]
+	}
+}
+
+// position returns the line and column [both starting at 1] of the offset
+func (ix *jsonIndex) position(offset int) (int, int) {
+	if offset > len(ix.data) {
+		offset = len(ix.data)
+	}
+	line, col := 1, 1
+	for _, c := range ix.data[:offset] {
+		if c == '\n' {
+			line, col = line+1, 1
+		} else {
+			col++
+		}
+	}
+	return line, col
+}
@@ -0,0 +1,418 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/juju/errors"
+)
+
+// this file contains everything related to generating the sample configuration
+// files, these are a ready to edit starting point for a new config file, that
+// contain placeholder values (or the defaults from the definition) for every field
+
+const (
+	// sampleOverrideName is the name of the override set in the generated sample
+	sampleOverrideName = "example"
+	// sampleHostname is the hostname that is mapped to the sample override set
+	sampleHostname = "host1.example.com"
+)
+
+// sampleNode is a single value in the sample configuration document
+type sampleNode struct {
+	// Key is the name of the value in its parent object, empty for array items
+	Key string
+	// Comment is written above the value in the yaml version of the sample
+	Comment string
+	// Value is the json literal of a scalar value
+	Value string
+	// Children contains the members of an object, or the items of an array
+	Children []*sampleNode
+	// IsObject and IsArray indicate if this is a container node
+	IsObject bool
+	IsArray  bool
+}
+
+// generateSample will load the config definition file, and write the sample
+// config.sample.json & config.sample.yaml files in the supplied directory.
+func generateSample(defFile, destDir string) error {
+	def, err := loadConfig(defFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(def.writeSample(destDir))
+}
+
+// writeSample writes the sample config files for the loaded definition to the
+// supplied directory
+func (def *templateData) writeSample(destDir string) error {
+	if err := def.setDefaultPackageName(destDir); err != nil {
+		return errors.Trace(err)
+	}
+	root := def.sampleConfigurations()
+
+	var j bytes.Buffer
+	root.writeJSON(&j, "")
+	j.WriteByte('\n')
+	if err := ioutil.WriteFile(filepath.Join(destDir, "config.sample.json"), j.Bytes(), 0664); err != nil {
+		return errors.Errorf("unable to create sample file: %v", err)
+	}
+
+	var y bytes.Buffer
+	fmt.Fprintf(&y, "# sample configuration for package %s, generated by configen\n", def.PackageName)
+	root.writeYAML(&y, "")
+	if err := ioutil.WriteFile(filepath.Join(destDir, "config.sample.yaml"), y.Bytes(), 0664); err != nil {
+		return errors.Errorf("unable to create sample file: %v", err)
+	}
+	return nil
+}
+
+// sampleConfigurations builds the sample document, it has the same structure
+// as the generated Configurations type, with a single host mapped to a single
+// named override that overrides the first value found in the Configuration
+func (td *templateData) sampleConfigurations() *sampleNode {
+	cfg := td.Structs["Configuration"]
+	override := &sampleNode{Key: sampleOverrideName, IsObject: true}
+	firstSampleLeaf(cfg, override)
+
+	defaults := sampleStruct(cfg, 0, nil)
+	defaults.Key = "Defaults"
+	defaults.Comment = "Defaults contains the base configuration, this applies unless it's overridden by a named override"
+	return &sampleNode{
+		IsObject: true,
+		Children: []*sampleNode{
+			defaults,
+			{
+				Key:      "Hosts",
+				Comment:  "Hosts is a map of hostname to the name of an override set",
+				IsObject: true,
+				Children: []*sampleNode{{Key: sampleHostname, Value: jsonString(sampleOverrideName)}},
+			},
+			{
+				Key:      "Overrides",
+				Comment:  "Overrides is a map of named configuration overrides,\nif the name starts with file:// then the overrides are loaded from that file",
+				IsObject: true,
+				Children: []*sampleNode{override},
+			},
+		},
+	}
+}
+
+// sampleStruct returns an object node with a value for every field in the struct, except the
+// deprecated fields, stack is the list of structs that it's nested in
+func sampleStruct(s *structInfo, variant int, stack []*structInfo) *sampleNode {
+	n := &sampleNode{IsObject: true}
+	nested := append(append(make([]*structInfo, 0, len(stack)+1), stack...), s)
+	for idx := range s.Fields {
+		if f := &s.Fields[idx]; f.embedded {
+			// the fields of the embedded type are promoted
+			n.Children = append(n.Children, sampleStruct(f.GoType.structDef, variant, nested).Children...)
+			continue
+		}
+		if s.Fields[idx].Deprecated != "" {
+			// deprecated fields aren't included in the samples
+			continue
+		}
+		n.Children = append(n.Children, sampleField(&s.Fields[idx], variant, nested))
+	}
+	return n
+}
+
+// sampleField returns the node for the field, it uses the default value from
+// the definition if there is one, otherwise a placeholder value based on the
+// type and name of the field.
+func sampleField(f *fieldInfo, variant int, stack []*structInfo) *sampleNode {
+	var n *sampleNode
+	if len(f.Default) > 0 {
+		n = sampleDefault(f.Default)
+	}
+	if n == nil {
+		n = sampleValue(f, variant, stack)
+	}
+	n.Key = f.Key()
+	n.Comment = f.Comment
+	if f.IsFixed() {
+		n.Comment += "\nit can't be set by the override sets"
+	}
+	return n
+}
+
+// sampleValue returns a placeholder value for the field, the slices, maps and pointers
+// of a recursive type are empty once it has been nested maxExampleDepth times
+func sampleValue(f *fieldInfo, variant int, stack []*structInfo) *sampleNode {
+	if t := f.GoType; t != nil && t.structDef != nil {
+		if t.isIndirect() && nestedCount(stack, t.structDef) >= maxExampleDepth {
+			switch {
+			case t.IsSlice():
+				return &sampleNode{IsArray: true}
+			case t.IsMap():
+				return &sampleNode{IsObject: true}
+			}
+			return &sampleNode{Value: "null"}
+		}
+		n := sampleStruct(t.structDef, variant, stack)
+		switch {
+		case t.IsSlice():
+			return &sampleNode{IsArray: true, Children: []*sampleNode{n}}
+		case t.IsMap():
+			n.Key = exampleMapKey
+			return &sampleNode{IsObject: true, Children: []*sampleNode{n}}
+		}
+		return n
+	}
+	if f.GoType != nil && f.GoType.external != nil {
+		if n := sampleDefault(f.GoType.external.Sample); n != nil {
+			return n
+		}
+	}
+	return sampleFieldValue(f.Name, f.Type, variant)
+}
+
+// sampleFieldValue returns a placeholder value for a field of one of the standard types
+func sampleFieldValue(name, typ string, variant int) *sampleNode {
+	if strings.HasPrefix(typ, "[]") {
+		return &sampleNode{IsArray: true, Children: []*sampleNode{sampleFieldValue(name, typ[2:], variant)}}
+	}
+	return &sampleNode{Value: placeholder(name, typ, variant)}
+}
+
+// placeholder returns a json literal that is a realistic looking value for
+// a field with the supplied name and type, variant allows for different values
+// to be generated for the same field.
+func placeholder(name, typ string, variant int) string {
+	n := strings.ToLower(name)
+	switch typ {
+	case "string":
+		kebab := strings.Join(splitWords(name), "-")
+		switch {
+		case strings.HasSuffix(n, "dir"):
+			return jsonString(fmt.Sprintf("/var/lib/service/%s", kebab))
+		case strings.HasSuffix(n, "file"):
+			return jsonString(fmt.Sprintf("/etc/service/%s", kebab))
+		case strings.Contains(n, "url") || strings.HasSuffix(n, "servers"):
+			return jsonString(fmt.Sprintf("https://host%d.example.com:8443", variant+1))
+		case strings.HasSuffix(n, "addr"):
+			return jsonString(fmt.Sprintf(":%d", 8080+variant))
+		case strings.HasSuffix(n, "level"):
+			return jsonString([]string{"INFO", "DEBUG", "ERROR"}[variant%3])
+		case variant > 0:
+			return jsonString(fmt.Sprintf("%s-%d", kebab, variant+1))
+		}
+		return jsonString(kebab)
+	case "*bool", "bool":
+		return fmt.Sprint(variant%2 == 1)
+	case "int", "int64", "uint64":
+		base := 10
+		switch {
+		case strings.HasSuffix(n, "secs") || strings.HasSuffix(n, "seconds"):
+			base = 30
+		case strings.HasSuffix(n, "days") || strings.HasSuffix(n, "retention"):
+			base = 7
+		case strings.HasSuffix(n, "mb"):
+			base = 100
+		case strings.HasSuffix(n, "port"):
+			return fmt.Sprint(8080 + variant)
+		}
+		return fmt.Sprint(base * (variant + 1))
+	case "float64":
+		return fmt.Sprint(0.5 * float64(variant+1))
+	case "Duration":
+		return jsonString([]string{"30s", "1m0s", "5m0s"}[variant%3])
+	}
+	return "null"
+}
+
+// sampleDefault returns the node for a default value from the definition,
+// or nil if its not valid json.
+func sampleDefault(raw json.RawMessage) *sampleNode {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil
+	}
+	return sampleJSONValue(v)
+}
+
+func sampleJSONValue(v interface{}) *sampleNode {
+	switch tv := v.(type) {
+	case []interface{}:
+		n := &sampleNode{IsArray: true}
+		for _, item := range tv {
+			n.Children = append(n.Children, sampleJSONValue(item))
+		}
+		return n
+	case map[string]interface{}:
+		n := &sampleNode{IsObject: true}
+		keys := make([]string, 0, len(tv))
+		for k := range tv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			c := sampleJSONValue(tv[k])
+			c.Key = k
+			n.Children = append(n.Children, c)
+		}
+		return n
+	}
+	b, _ := json.Marshal(v)
+	return &sampleNode{Value: string(b)}
+}
+
+// firstSampleLeaf populates the override node with the path to the first
+// non struct field in the supplied struct, and returns the leaf node
+func firstSampleLeaf(s *structInfo, parent *sampleNode) *sampleNode {
+	for idx := range s.Fields {
+		f := &s.Fields[idx]
+		if f.embedded {
+			// the fields of the embedded type are promoted into the parent
+			if leaf := firstSampleLeaf(f.GoType.structDef, parent); leaf != nil {
+				return leaf
+			}
+			continue
+		}
+		if f.Deprecated != "" || f.IsFixed() {
+			continue
+		}
+		if f.IsStruct() {
+			child := &sampleNode{Key: f.Key(), IsObject: true}
+			if leaf := firstSampleLeaf(f.GoType.structDef, child); leaf != nil {
+				parent.Children = append(parent.Children, child)
+				return leaf
+			}
+			continue
+		}
+		leaf := sampleValue(f, 1, nil)
+		leaf.Key = f.Key()
+		leaf.Comment = f.Comment
+		parent.Children = append(parent.Children, leaf)
+		return leaf
+	}
+	return nil
+}
+
+// writeJSON writes the node as indented json
+func (n *sampleNode) writeJSON(w *bytes.Buffer, indent string) {
+	if !n.IsObject && !n.IsArray {
+		w.WriteString(n.Value)
+		return
+	}
+	open, close := "[", "]"
+	if n.IsObject {
+		open, close = "{", "}"
+	}
+	if len(n.Children) == 0 {
+		w.WriteString(open + close)
+		return
+	}
+	w.WriteString(open + "\n")
+	for idx, c := range n.Children {
+		w.WriteString(indent + "  ")
+		if n.IsObject {
+			w.WriteString(jsonString(c.Key) + ": ")
+		}
+		c.writeJSON(w, indent+"  ")
+		if idx < len(n.Children)-1 {
+			w.WriteByte(',')
+		}
+		w.WriteByte('\n')
+	}
+	w.WriteString(indent + close)
+}
+
+// writeYAML writes the members of an object node as yaml, with the comments
+// written above each value.
+func (n *sampleNode) writeYAML(w *bytes.Buffer, indent string) {
+	for _, c := range n.Children {
+		writeYAMLComment(w, indent, c.Comment)
+		w.WriteString(indent + yamlKey(c.Key) + ":")
+		c.writeYAMLValue(w, indent)
+	}
+}
+
+// writeYAMLValue writes the value of a node, following its key
+func (n *sampleNode) writeYAMLValue(w *bytes.Buffer, indent string) {
+	switch {
+	case n.IsObject && len(n.Children) == 0:
+		w.WriteString(" {}\n")
+	case n.IsArray && len(n.Children) == 0:
+		w.WriteString(" []\n")
+	case n.IsObject:
+		w.WriteByte('\n')
+		n.writeYAML(w, indent+"  ")
+	case n.IsArray:
+		w.WriteByte('\n')
+		for _, item := range n.Children {
+			if item.IsObject && len(item.Children) > 0 {
+				first := item.Children[0]
+				writeYAMLComment(w, indent+"  ", first.Comment)
+				w.WriteString(indent + "  - " + yamlKey(first.Key) + ":")
+				first.writeYAMLValue(w, indent+"    ")
+				rest := &sampleNode{IsObject: true, Children: item.Children[1:]}
+				rest.writeYAML(w, indent+"    ")
+				continue
+			}
+			w.WriteString(indent + "  -")
+			item.writeYAMLValue(w, indent+"  ")
+		}
+	default:
+		w.WriteString(" " + n.Value + "\n")
+	}
+}
+
+func writeYAMLComment(w *bytes.Buffer, indent, comment string) {
+	if strings.TrimSpace(comment) == "" {
+		return
+	}
+	for _, l := range strings.Split(comment, "\n") {
+		w.WriteString(indent + "# " + strings.TrimSpace(l) + "\n")
+	}
+}
+
+// yamlKey returns the key, quoted if it contains characters that are not
+// safe in a plain yaml scalar
+func yamlKey(k string) string {
+	for _, r := range k {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '.' {
+			return jsonString(k)
+		}
+	}
+	return k
+}
+
+func jsonString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// splitWords splits a go style identifier into its lower case words,
+// e.g. HTTPServerTLS is split into http, server, tls
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if i < len(runes) {
+			cur, prev := runes[i], runes[i-1]
+			boundary := cur == '_' || cur == '-' ||
+				(unicode.IsUpper(cur) && unicode.IsLower(prev)) ||
+				(unicode.IsUpper(cur) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))
+			if !boundary {
+				continue
+			}
+		}
+		w := strings.Trim(string(runes[start:i]), "_-")
+		if w != "" {
+			words = append(words, strings.ToLower(w))
+		}
+		start = i
+	}
+	return words
+}
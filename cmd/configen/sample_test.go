@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateSample(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sample")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = generateSample("testdata/gen_def.json", dir)
+	require.NoError(t, err)
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "config.sample.json"))
+	require.NoError(t, err)
+
+	var sample struct {
+		Defaults  map[string]interface{}
+		Hosts     map[string]string
+		Overrides map[string]map[string]interface{}
+	}
+	require.NoError(t, json.Unmarshal(b, &sample), string(b))
+	assert.Len(t, sample.Defaults, 7)
+	assert.Equal(t, map[string]string{sampleHostname: sampleOverrideName}, sample.Hosts)
+	require.Contains(t, sample.Overrides, sampleOverrideName)
+	assert.Equal(t, map[string]interface{}{"HTTP": map[string]interface{}{"ServiceName": "service-name-2"}}, sample.Overrides[sampleOverrideName])
+
+	// defaults from the definition are used
+	client := sample.Defaults["Client"].(map[string]interface{})
+	assert.Equal(t, "30s", client["Timeout"])
+	assert.Equal(t, []interface{}{"https://host1.example.com:8443"}, client["Servers"])
+	audit := sample.Defaults["Audit"].(map[string]interface{})
+	assert.Equal(t, float64(7), audit["MaxAgeDays"])
+	assert.Equal(t, float64(100), audit["MaxSizeMb"])
+
+	b, err = ioutil.ReadFile(filepath.Join(dir, "config.sample.yaml"))
+	require.NoError(t, err)
+	y := string(b)
+	assert.True(t, strings.HasPrefix(y, "# sample configuration for package testgen"))
+	assert.Contains(t, y, "\n  # HTTP contains the config for the HTTPS/JSON API Service\n  HTTP:\n")
+	assert.Contains(t, y, "\n  LogLevels:\n    # Repo specifies the repo name, or '*' for all repos [Global]\n    - Repo: \"repo\"\n      # Package specifies the package name\n      Package: \"package\"\n")
+	assert.Contains(t, y, "\nHosts:\n  host1.example.com: \"example\"\n")
+	assert.Contains(t, y, "\n    HTTP:\n      # ServiceName specifies name of the service: HTTP|HTTPS|WebAPI\n      ServiceName: \"service-name-2\"\n")
+}
+
+func Test_splitWords(t *testing.T) {
+	tcases := map[string][]string{
+		"HTTPServerTLS": {"http", "server", "tls"},
+		"CertFile":      {"cert", "file"},
+		"MaxSizeMb":     {"max", "size", "mb"},
+		"bind_addr":     {"bind", "addr"},
+		"VIPName":       {"vip", "name"},
+		"a":             {"a"},
+	}
+	for name, exp := range tcases {
+		assert.Equal(t, exp, splitWords(name), name)
+	}
+}
+
+func Test_placeholder(t *testing.T) {
+	assert.Equal(t, `"/etc/service/cert-file"`, placeholder("CertFile", "string", 0))
+	assert.Equal(t, `"/var/lib/service/data-dir"`, placeholder("DataDir", "string", 0))
+	assert.Equal(t, `":8081"`, placeholder("BindAddr", "string", 1))
+	assert.Equal(t, `30`, placeholder("HeartbeatSecs", "int", 0))
+	assert.Equal(t, `true`, placeholder("Debug", "*bool", 1))
+	assert.Equal(t, `"1m0s"`, placeholder("Timeout", "Duration", 1))
+	assert.Equal(t, `null`, placeholder("Bob", "Alice", 0))
+}
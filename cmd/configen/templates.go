@@ -135,7 +135,977 @@ func (f *FileInfo) Sys() interface{} {
 func init() {
 	assets = &FileSystem{
 		files: map[string]File{
-			"/config.go.template": {
+			"/cli.go.template": {
+				data: []byte{
+					0x2f, 0x2f, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x7b,
+					0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x69,
+					0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+					0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x70, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x69, 0x74, 0x20, 0x63,
+					0x61, 0x6e, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x2c, 0x20,
+					0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x20, 0x77, 0x68, 0x65, 0x72,
+					0x65, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x20, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x2c,
+					0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x6c,
+					0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x20, 0x74, 0x6f, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x61, 0x6e,
+					0x64, 0x20, 0x64, 0x69, 0x66, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x20, 0x6f, 0x66, 0x20, 0x32, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73,
+					0x2e, 0x0a, 0x2f, 0x2f, 0x0a, 0x2f, 0x2f, 0x20, 0x2a, 0x2a, 0x2a, 0x20,
+					0x54, 0x48, 0x49, 0x53, 0x20, 0x49, 0x53, 0x20, 0x47, 0x45, 0x4e, 0x45,
+					0x52, 0x41, 0x54, 0x45, 0x44, 0x20, 0x43, 0x4f, 0x44, 0x45, 0x3a, 0x20,
+					0x44, 0x4f, 0x20, 0x4e, 0x4f, 0x54, 0x20, 0x45, 0x44, 0x49, 0x54, 0x20,
+					0x2a, 0x2a, 0x2a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20,
+					0x6d, 0x61, 0x69, 0x6e, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+					0x20, 0x28, 0x0a, 0x09, 0x22, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+					0x67, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x0a, 0x09, 0x22, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x66, 0x6c, 0x61, 0x67,
+					0x22, 0x0a, 0x09, 0x22, 0x66, 0x6d, 0x74, 0x22, 0x0a, 0x09, 0x22, 0x69,
+					0x6f, 0x22, 0x0a, 0x09, 0x22, 0x6f, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x73,
+					0x6f, 0x72, 0x74, 0x22, 0x0a, 0x09, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64,
+					0x65, 0x22, 0x0a, 0x0a, 0x09, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x22, 0x7b,
+					0x7b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x68,
+					0x7d, 0x7d, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+					0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x20, 0x3d, 0x20, 0x60, 0x75, 0x73,
+					0x61, 0x67, 0x65, 0x3a, 0x20, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2d, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x20, 0x2d, 0x63, 0x20, 0x3c, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3e, 0x20, 0x5b, 0x2d,
+					0x65, 0x6e, 0x76, 0x20, 0x3c, 0x65, 0x6e, 0x76, 0x20, 0x76, 0x61, 0x72,
+					0x3e, 0x5d, 0x20, 0x5b, 0x2d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x3d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5d, 0x20, 0x3c,
+					0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x3e, 0x20, 0x5b, 0x6f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+					0x61, 0x6e, 0x64, 0x73, 0x3a, 0x0a, 0x20, 0x20, 0x73, 0x68, 0x6f, 0x77,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x5b, 0x2d, 0x68, 0x6f, 0x73, 0x74, 0x20,
+					0x6e, 0x61, 0x6d, 0x65, 0x5d, 0x20, 0x5b, 0x2d, 0x6f, 0x20, 0x6a, 0x73,
+					0x6f, 0x6e, 0x7c, 0x79, 0x61, 0x6d, 0x6c, 0x5d, 0x20, 0x20, 0x70, 0x72,
+					0x69, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x66, 0x66, 0x65,
+					0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20,
+					0x61, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x70,
+					0x6c, 0x61, 0x69, 0x6e, 0x20, 0x20, 0x5b, 0x2d, 0x68, 0x6f, 0x73, 0x74,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x5d, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x70,
+					0x72, 0x69, 0x6e, 0x74, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x66, 0x20,
+					0x69, 0x74, 0x20, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x20, 0x66, 0x72, 0x6f,
+					0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x0a, 0x20, 0x20, 0x76, 0x61, 0x6c, 0x69,
+					0x64, 0x61, 0x74, 0x65, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x63, 0x68,
+					0x65, 0x63, 0x6b, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79,
+					0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65,
+					0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x0a, 0x20, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
+					0x0a, 0x20, 0x20, 0x64, 0x69, 0x66, 0x66, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x2d, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x61, 0x20, 0x2d, 0x68, 0x6f, 0x73,
+					0x74, 0x20, 0x62, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+					0x65, 0x73, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x32, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x73, 0x0a, 0x60, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x6d, 0x61, 0x69, 0x6e, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x75, 0x6e,
+					0x28, 0x6f, 0x73, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x5b, 0x31, 0x3a, 0x5d,
+					0x2c, 0x20, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x29,
+					0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72,
+					0x69, 0x6e, 0x74, 0x6c, 0x6e, 0x28, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x64,
+					0x65, 0x72, 0x72, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x6f, 0x73, 0x2e, 0x45,
+					0x78, 0x69, 0x74, 0x28, 0x31, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x72, 0x75, 0x6e, 0x20, 0x70, 0x61, 0x72, 0x73,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+					0x6e, 0x64, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x2c, 0x20, 0x61, 0x6e, 0x64,
+					0x20, 0x72, 0x75, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
+					0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f,
+					0x75, 0x74, 0x70, 0x75, 0x74, 0x20, 0x69, 0x73, 0x20, 0x77, 0x72, 0x69,
+					0x74, 0x74, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x72, 0x75, 0x6e, 0x28, 0x61, 0x72, 0x67, 0x73, 0x20,
+					0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x77, 0x20,
+					0x69, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x29, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67,
+					0x73, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x4e, 0x65,
+					0x77, 0x46, 0x6c, 0x61, 0x67, 0x53, 0x65, 0x74, 0x28, 0x22, 0x7b, 0x7b,
+					0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2c, 0x20,
+					0x66, 0x6c, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75,
+					0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x0a, 0x09, 0x66,
+					0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x20, 0x3d,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x7b, 0x20, 0x66, 0x6d,
+					0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x28, 0x66, 0x6c, 0x61,
+					0x67, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x28, 0x29, 0x2c,
+					0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x29, 0x20, 0x7d, 0x0a, 0x09, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x3a, 0x3d,
+					0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x28, 0x22, 0x63, 0x22, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22,
+					0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x29,
+					0x0a, 0x09, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x20, 0x3a, 0x3d, 0x20,
+					0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x28, 0x22, 0x65, 0x6e, 0x76, 0x22, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20,
+					0x22, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+					0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x74, 0x68,
+					0x61, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x2c, 0x20, 0x69, 0x66, 0x20, 0x2d, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x69,
+					0x73, 0x6e, 0x27, 0x74, 0x20, 0x73, 0x65, 0x74, 0x22, 0x29, 0x0a, 0x09,
+					0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x7b, 0x7d, 0x0a, 0x09, 0x66,
+					0x6c, 0x61, 0x67, 0x73, 0x2e, 0x56, 0x61, 0x72, 0x28, 0x6c, 0x61, 0x62,
+					0x65, 0x6c, 0x73, 0x2c, 0x20, 0x22, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22,
+					0x2c, 0x20, 0x22, 0x41, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x20, 0x75,
+					0x73, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+					0x72, 0x73, 0x2c, 0x20, 0x61, 0x73, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62,
+					0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
+					0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x74, 0x69, 0x6d,
+					0x65, 0x73, 0x22, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x50, 0x61,
+					0x72, 0x73, 0x65, 0x28, 0x61, 0x72, 0x67, 0x73, 0x29, 0x3b, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x2a, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x22,
+					0x22, 0x20, 0x7c, 0x7c, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4e,
+					0x41, 0x72, 0x67, 0x28, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x73, 0x61,
+					0x67, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x4e, 0x65, 0x77,
+					0x28, 0x22, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x69, 0x6c, 0x65,
+					0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+					0x6e, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73,
+					0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x2e, 0x50, 0x61,
+					0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e,
+					0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x2a, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72,
+					0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x63, 0x6d, 0x64, 0x2c, 0x20, 0x63,
+					0x6d, 0x64, 0x41, 0x72, 0x67, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x6c,
+					0x61, 0x67, 0x73, 0x2e, 0x41, 0x72, 0x67, 0x28, 0x30, 0x29, 0x2c, 0x20,
+					0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x28, 0x29,
+					0x5b, 0x31, 0x3a, 0x5d, 0x0a, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+					0x20, 0x63, 0x6d, 0x64, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x65,
+					0x20, 0x22, 0x73, 0x68, 0x6f, 0x77, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x28, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x2a, 0x65, 0x6e, 0x76,
+					0x4b, 0x65, 0x79, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x2c,
+					0x20, 0x63, 0x6d, 0x64, 0x41, 0x72, 0x67, 0x73, 0x2c, 0x20, 0x77, 0x29,
+					0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x65, 0x78, 0x70, 0x6c,
+					0x61, 0x69, 0x6e, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x28, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x2a, 0x65, 0x6e, 0x76,
+					0x4b, 0x65, 0x79, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x2c,
+					0x20, 0x63, 0x6d, 0x64, 0x41, 0x72, 0x67, 0x73, 0x2c, 0x20, 0x77, 0x29,
+					0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x69,
+					0x64, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+					0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x2a, 0x65,
+					0x6e, 0x76, 0x4b, 0x65, 0x79, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x73, 0x2c, 0x20, 0x77, 0x29, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20,
+					0x22, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x28,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x77, 0x29, 0x0a,
+					0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x64, 0x69, 0x66, 0x66, 0x22,
+					0x3a, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x64,
+					0x69, 0x66, 0x66, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c,
+					0x20, 0x2a, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x2c, 0x20, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x2c, 0x20, 0x63, 0x6d, 0x64, 0x41, 0x72, 0x67,
+					0x73, 0x2c, 0x20, 0x77, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66, 0x6c,
+					0x61, 0x67, 0x73, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x28, 0x29, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x75, 0x6e, 0x6b, 0x6e,
+					0x6f, 0x77, 0x6e, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20,
+					0x25, 0x71, 0x22, 0x2c, 0x20, 0x63, 0x6d, 0x64, 0x29, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+					0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x20, 0x74,
+					0x68, 0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73,
+					0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6d, 0x6f, 0x72,
+					0x65, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x0a,
+					0x74, 0x79, 0x70, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
+					0x74, 0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x68, 0x6f, 0x73,
+					0x74, 0x4c, 0x69, 0x73, 0x74, 0x29, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x28, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x28, 0x2a, 0x68,
+					0x2c, 0x20, 0x22, 0x2c, 0x22, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x68, 0x6f, 0x73, 0x74, 0x4c,
+					0x69, 0x73, 0x74, 0x29, 0x20, 0x53, 0x65, 0x74, 0x28, 0x76, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x20, 0x7b, 0x0a, 0x09, 0x2a, 0x68, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70,
+					0x65, 0x6e, 0x64, 0x28, 0x2a, 0x68, 0x2c, 0x20, 0x76, 0x29, 0x0a, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69,
+					0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x66, 0x6c, 0x61, 0x67,
+					0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x73, 0x65, 0x74, 0x73, 0x20, 0x61,
+					0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x2c, 0x20, 0x61, 0x73, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x3d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x0a, 0x74, 0x79,
+					0x70, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x28, 0x6c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73,
+					0x74, 0x29, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65,
+					0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28, 0x5b, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x30, 0x2c, 0x20, 0x6c,
+					0x65, 0x6e, 0x28, 0x6c, 0x29, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20,
+					0x6b, 0x2c, 0x20, 0x76, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x73, 0x20,
+					0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x72, 0x65, 0x73,
+					0x2c, 0x20, 0x6b, 0x2b, 0x22, 0x3d, 0x22, 0x2b, 0x76, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x73, 0x28, 0x72, 0x65, 0x73, 0x29, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+					0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x28, 0x72, 0x65, 0x73, 0x2c, 0x20, 0x22,
+					0x2c, 0x22, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x28, 0x6c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+					0x29, 0x20, 0x53, 0x65, 0x74, 0x28, 0x76, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a,
+					0x09, 0x69, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x28,
+					0x76, 0x2c, 0x20, 0x27, 0x3d, 0x27, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x69, 0x20, 0x3c, 0x3d, 0x20, 0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+					0x64, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x20, 0x25, 0x71, 0x2c, 0x20,
+					0x69, 0x74, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+					0x2c, 0x20, 0x76, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x6c, 0x5b, 0x76,
+					0x5b, 0x3a, 0x69, 0x5d, 0x5d, 0x20, 0x3d, 0x20, 0x76, 0x5b, 0x69, 0x2b,
+					0x31, 0x3a, 0x5d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x73, 0x68, 0x6f, 0x77, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+					0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65,
+					0x6e, 0x76, 0x4b, 0x65, 0x79, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x6c, 0x61, 0x62,
+					0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x2c, 0x20, 0x61, 0x72, 0x67, 0x73,
+					0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x77,
+					0x20, 0x69, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x29, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x6c, 0x61,
+					0x67, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x4e,
+					0x65, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x53, 0x65, 0x74, 0x28, 0x22, 0x73,
+					0x68, 0x6f, 0x77, 0x22, 0x2c, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x43,
+					0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x29, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x3a, 0x3d,
+					0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x28, 0x22, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x22,
+					0x2c, 0x20, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x74, 0x6f, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x66, 0x6f, 0x72, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f,
+					0x53, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29,
+					0x0a, 0x09, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x3a, 0x3d, 0x20,
+					0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x28, 0x22, 0x6f, 0x22, 0x2c, 0x20, 0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x22,
+					0x2c, 0x20, 0x22, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x20, 0x66, 0x6f,
+					0x72, 0x6d, 0x61, 0x74, 0x2c, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x6f,
+					0x72, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x22, 0x29, 0x0a, 0x09, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x6c, 0x61, 0x67,
+					0x73, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x28, 0x61, 0x72, 0x67, 0x73,
+					0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
+					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x63, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+					0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x2c, 0x20, 0x2a, 0x68, 0x6f,
+					0x73, 0x74, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x29, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x62, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+					0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x49, 0x6e, 0x64, 0x65,
+					0x6e, 0x74, 0x28, 0x63, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x20,
+					0x20, 0x20, 0x20, 0x22, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a,
+					0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48,
+					0x61, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x7d, 0x7d, 0x0a,
+					0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65,
+					0x63, 0x72, 0x65, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x2c, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x73, 0x20,
+					0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c,
+					0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x44, 0x69, 0x66, 0x66, 0x0a, 0x09,
+					0x64, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65,
+					0x77, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x64,
+					0x65, 0x72, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x29,
+					0x29, 0x29, 0x0a, 0x09, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x4e, 0x75, 0x6d,
+					0x62, 0x65, 0x72, 0x28, 0x29, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x20, 0x6d,
+					0x61, 0x73, 0x6b, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+					0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x20, 0x64, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+					0x28, 0x26, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x29, 0x3b, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x6d, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x6d,
+					0x61, 0x73, 0x6b, 0x65, 0x64, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x62,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+					0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x49, 0x6e, 0x64, 0x65,
+					0x6e, 0x74, 0x28, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x2c, 0x20, 0x22,
+					0x22, 0x2c, 0x20, 0x22, 0x20, 0x20, 0x20, 0x20, 0x22, 0x29, 0x3b, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72,
+					0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x2a,
+					0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x61,
+					0x73, 0x65, 0x20, 0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x09,
+					0x09, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x66, 0x6d,
+					0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x6c, 0x6e, 0x28, 0x77,
+					0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x29, 0x29,
+					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72,
+					0x72, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x79, 0x61, 0x6d,
+					0x6c, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x76, 0x61, 0x72, 0x20, 0x76, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a,
+					0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61,
+					0x6c, 0x28, 0x62, 0x2c, 0x20, 0x26, 0x76, 0x29, 0x3b, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65,
+					0x59, 0x41, 0x4d, 0x4c, 0x28, 0x77, 0x2c, 0x20, 0x76, 0x2c, 0x20, 0x22,
+					0x22, 0x29, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x66, 0x28, 0x22, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x6f,
+					0x75, 0x74, 0x70, 0x75, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+					0x20, 0x25, 0x71, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x6f,
+					0x72, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x22, 0x2c, 0x20, 0x2a, 0x66, 0x6f,
+					0x72, 0x6d, 0x61, 0x74, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x28, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61,
+					0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x2c, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x73, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x2c,
+					0x20, 0x61, 0x72, 0x67, 0x73, 0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x2c, 0x20, 0x77, 0x20, 0x69, 0x6f, 0x2e, 0x57, 0x72, 0x69,
+					0x74, 0x65, 0x72, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b,
+					0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x66,
+					0x6c, 0x61, 0x67, 0x2e, 0x4e, 0x65, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x53,
+					0x65, 0x74, 0x28, 0x22, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x22,
+					0x2c, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69,
+					0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x0a,
+					0x09, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x6c, 0x61,
+					0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x22, 0x68,
+					0x6f, 0x73, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x48,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x65,
+					0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x66, 0x6f, 0x72, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x53,
+					0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x66,
+					0x6c, 0x61, 0x67, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x28, 0x61,
+					0x72, 0x67, 0x73, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
+					0x73, 0x65, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+					0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62,
+					0x65, 0x6c, 0x73, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x2c, 0x20,
+					0x2a, 0x68, 0x6f, 0x73, 0x74, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x73, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x66,
+					0x28, 0x77, 0x2c, 0x20, 0x22, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x3a, 0x20, 0x25, 0x73, 0x5c, 0x6e, 0x22, 0x2c, 0x20, 0x73, 0x65,
+					0x6c, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a,
+					0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x70,
+					0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20,
+					0x61, 0x73, 0x20, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+					0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73,
+					0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x69, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x6f, 0x75, 0x72, 0x63,
+					0x65, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x3a,
+					0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28, 0x5b, 0x5d, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x2c, 0x20, 0x30, 0x2c, 0x20, 0x6c, 0x65, 0x6e, 0x28,
+					0x73, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+					0x73, 0x29, 0x2b, 0x31, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f,
+					0x2c, 0x20, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x73, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+					0x72, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46,
+					0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x73,
+					0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x20, 0x25, 0x73, 0x20,
+					0x2d, 0x3e, 0x20, 0x25, 0x73, 0x5c, 0x6e, 0x22, 0x2c, 0x20, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x28, 0x73, 0x2e, 0x4d, 0x61,
+					0x74, 0x63, 0x68, 0x29, 0x2c, 0x20, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x29, 0x0a, 0x09, 0x09, 0x61, 0x70, 0x70, 0x6c,
+					0x69, 0x65, 0x64, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
+					0x28, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x2c, 0x20, 0x73, 0x2e,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a, 0x09, 0x7d,
+					0x0a, 0x09, 0x69, 0x66, 0x20, 0x73, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69,
+					0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x3a, 0x20, 0x25, 0x73, 0x5c, 0x6e, 0x22, 0x2c,
+					0x20, 0x73, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x29, 0x0a, 0x09, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+					0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x61, 0x70,
+					0x70, 0x6c, 0x69, 0x65, 0x64, 0x2c, 0x20, 0x73, 0x65, 0x6c, 0x2e, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x61, 0x70, 0x70, 0x6c,
+					0x69, 0x65, 0x64, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74,
+					0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x3a, 0x20, 0x6e, 0x6f, 0x6e, 0x65, 0x2c, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x61,
+					0x72, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x5c, 0x6e, 0x22, 0x29, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x3a,
+					0x3d, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x7d, 0x0a, 0x09, 0x63,
+					0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x6f,
+					0x6e, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e,
+					0x67, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2c, 0x20, 0x63, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a,
+					0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x63, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x63, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x28, 0x6e, 0x65, 0x78, 0x74,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63,
+					0x65, 0x5b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x74,
+					0x68, 0x5d, 0x20, 0x3d, 0x20, 0x22, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x20, 0x22, 0x20, 0x2b, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x0a,
+					0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73,
+					0x20, 0x61, 0x72, 0x65, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x64, 0x20,
+					0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x73, 0x65,
+					0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x77, 0x61, 0x6c, 0x6b, 0x65, 0x64,
+					0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x69, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x28,
+					0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2c,
+					0x20, 0x27, 0x5b, 0x27, 0x29, 0x3b, 0x20, 0x69, 0x20, 0x3e, 0x3d, 0x20,
+					0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x73, 0x6f, 0x75, 0x72,
+					0x63, 0x65, 0x5b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x61,
+					0x74, 0x68, 0x5b, 0x3a, 0x69, 0x5d, 0x5d, 0x20, 0x3d, 0x20, 0x22, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x22, 0x20, 0x2b, 0x20,
+					0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x09, 0x63, 0x20, 0x3d, 0x20, 0x6e, 0x65, 0x78, 0x74,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x28,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x70, 0x61, 0x74, 0x68, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x73, 0x72, 0x63, 0x2c, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+					0x20, 0x3a, 0x3d, 0x20, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5b, 0x70,
+					0x61, 0x74, 0x68, 0x5d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x21, 0x65,
+					0x78, 0x69, 0x73, 0x74, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x73,
+					0x72, 0x63, 0x20, 0x3d, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x22, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x62, 0x2c, 0x20,
+					0x5f, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61,
+					0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x76, 0x29, 0x0a, 0x7b, 0x7b, 0x2d,
+					0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x65, 0x63, 0x72,
+					0x65, 0x74, 0x73, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73,
+					0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x70, 0x61, 0x74, 0x68, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x62, 0x2c, 0x20, 0x5f, 0x20, 0x3d,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61,
+					0x6c, 0x28, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b,
+					0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70,
+					0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x25, 0x73,
+					0x20, 0x3d, 0x20, 0x25, 0x73, 0x20, 0x28, 0x25, 0x73, 0x29, 0x5c, 0x6e,
+					0x22, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x62, 0x2c, 0x20,
+					0x73, 0x72, 0x63, 0x29, 0x0a, 0x09, 0x7d, 0x29, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x2f, 0x2f, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+					0x20, 0x61, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x63,
+					0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x64, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x20, 0x73, 0x65, 0x74, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x28, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2c, 0x20, 0x63, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x20, 0x28, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+					0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x73, 0x69,
+					0x6e, 0x67, 0x6c, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x2e, 0x50,
+					0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x7b, 0x0a, 0x09, 0x09, 0x44, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x73, 0x3a, 0x20, 0x20, 0x2a, 0x63, 0x2c, 0x0a, 0x09, 0x09,
+					0x48, 0x6f, 0x73, 0x74, 0x73, 0x3a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x6d,
+					0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x7d, 0x2c, 0x0a, 0x09, 0x09, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x73, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63,
+					0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+					0x5b, 0x6e, 0x61, 0x6d, 0x65, 0x5d, 0x7d, 0x2c, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x73, 0x69, 0x6e, 0x67,
+					0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x76,
+					0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20,
+					0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x20,
+					0x62, 0x79, 0x20, 0x61, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+					0x63, 0x61, 0x6e, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x69, 0x74,
+					0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x0a, 0x74, 0x79, 0x70,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7b, 0x0a,
+					0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x28, 0x29, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x28, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50,
+					0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65,
+					0x6c, 0x73, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+					0x2c, 0x20, 0x77, 0x20, 0x69, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+					0x72, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09,
+					0x76, 0x61, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73,
+					0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x63,
+					0x68, 0x65, 0x63, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63,
+					0x28, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x2c, 0x20, 0x63, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x76, 0x2c, 0x20, 0x6f,
+					0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x28, 0x63, 0x29, 0x2e, 0x28, 0x76, 0x61, 0x6c,
+					0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x29, 0x3b, 0x20, 0x6f, 0x6b, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x76, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+					0x65, 0x28, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x70, 0x72,
+					0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70,
+					0x65, 0x6e, 0x64, 0x28, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73,
+					0x2c, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x74,
+					0x66, 0x28, 0x22, 0x25, 0x73, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x29, 0x0a,
+					0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x28, 0x22, 0x64, 0x65, 0x66, 0x61,
+					0x75, 0x6c, 0x74, 0x73, 0x22, 0x2c, 0x20, 0x26, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+					0x29, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x6d,
+					0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x62, 0x6f,
+					0x6f, 0x6c, 0x7b, 0x7d, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x65,
+					0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20,
+					0x68, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x75, 0x73, 0x65, 0x64, 0x5b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x5b, 0x68, 0x5d, 0x5d, 0x20,
+					0x3d, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x09, 0x09, 0x63, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+					0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x2c, 0x20, 0x68, 0x2c, 0x20,
+					0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+					0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x70,
+					0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2c, 0x20, 0x66, 0x6d, 0x74,
+					0x2e, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x22, 0x68, 0x6f,
+					0x73, 0x74, 0x20, 0x25, 0x73, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x68, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x09,
+					0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x28, 0x22, 0x68, 0x6f,
+					0x73, 0x74, 0x20, 0x22, 0x2b, 0x68, 0x2c, 0x20, 0x63, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x21, 0x75,
+					0x73, 0x65, 0x64, 0x5b, 0x6e, 0x61, 0x6d, 0x65, 0x5d, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e,
+					0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x77, 0x61, 0x72, 0x6e, 0x69,
+					0x6e, 0x67, 0x3a, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x20, 0x25, 0x73, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x75, 0x73,
+					0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x68, 0x6f,
+					0x73, 0x74, 0x5c, 0x6e, 0x22, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72,
+					0x20, 0x5f, 0x2c, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x20,
+					0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x2e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+					0x73, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e,
+					0x46, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22,
+					0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x3a, 0x20, 0x25, 0x73, 0x5c,
+					0x6e, 0x22, 0x2c, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x6c, 0x65, 0x6e, 0x28,
+					0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x29, 0x20, 0x3e, 0x20,
+					0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c,
+					0x20, 0x70, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74,
+					0x6c, 0x6e, 0x28, 0x77, 0x2c, 0x20, 0x70, 0x29, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d,
+					0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x74, 0x68,
+					0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x68, 0x61, 0x73, 0x20, 0x25, 0x64, 0x20, 0x70,
+					0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x22, 0x2c, 0x20, 0x6c, 0x65,
+					0x6e, 0x28, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x29, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72,
+					0x69, 0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x6f, 0x6b, 0x2c,
+					0x20, 0x25, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x5c, 0x6e, 0x22,
+					0x2c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x73, 0x29, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x28, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2c, 0x20, 0x77, 0x20, 0x69, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+					0x72, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09,
+					0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x68, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64,
+					0x48, 0x6f, 0x73, 0x74, 0x73, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46,
+					0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x25,
+					0x73, 0x20, 0x2d, 0x3e, 0x20, 0x25, 0x73, 0x5c, 0x6e, 0x22, 0x2c, 0x20,
+					0x68, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x48,
+					0x6f, 0x73, 0x74, 0x73, 0x5b, 0x68, 0x5d, 0x29, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x64, 0x69, 0x66, 0x66,
+					0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x7b, 0x7b,
+					0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65,
+					0x79, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69,
+					0x73, 0x74, 0x2c, 0x20, 0x61, 0x72, 0x67, 0x73, 0x20, 0x5b, 0x5d, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x77, 0x20, 0x69, 0x6f, 0x2e,
+					0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x3a,
+					0x3d, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x4e, 0x65, 0x77, 0x46, 0x6c,
+					0x61, 0x67, 0x53, 0x65, 0x74, 0x28, 0x22, 0x64, 0x69, 0x66, 0x66, 0x22,
+					0x2c, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69,
+					0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x0a,
+					0x09, 0x76, 0x61, 0x72, 0x20, 0x68, 0x6c, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x4c, 0x69, 0x73, 0x74, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+					0x56, 0x61, 0x72, 0x28, 0x26, 0x68, 0x6c, 0x2c, 0x20, 0x22, 0x68, 0x6f,
+					0x73, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+					0x65, 0x2c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73,
+					0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x74, 0x77, 0x69,
+					0x63, 0x65, 0x22, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x50, 0x61,
+					0x72, 0x73, 0x65, 0x28, 0x61, 0x72, 0x67, 0x73, 0x29, 0x3b, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x6c, 0x65, 0x6e, 0x28,
+					0x68, 0x6c, 0x29, 0x20, 0x21, 0x3d, 0x20, 0x32, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x28, 0x22, 0x64, 0x69, 0x66, 0x66,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x20, 0x32, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x73, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20,
+					0x64, 0x69, 0x66, 0x66, 0x20, 0x2d, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x61,
+					0x20, 0x2d, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62, 0x22, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x61, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x46, 0x6f, 0x72,
+					0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65,
+					0x79, 0x2c, 0x20, 0x68, 0x6c, 0x5b, 0x30, 0x5d, 0x2c, 0x20, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x46, 0x6f,
+					0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x65, 0x6e, 0x76, 0x4b,
+					0x65, 0x79, 0x2c, 0x20, 0x68, 0x6c, 0x5b, 0x31, 0x5d, 0x2c, 0x20, 0x6c,
+					0x61, 0x62, 0x65, 0x6c, 0x73, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+					0x20, 0x3a, 0x3d, 0x20, 0x61, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x28, 0x62,
+					0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x63, 0x68,
+					0x61, 0x6e, 0x67, 0x65, 0x73, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69,
+					0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x6e, 0x6f, 0x20, 0x64,
+					0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x62,
+					0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x25, 0x73, 0x20, 0x61, 0x6e,
+					0x64, 0x20, 0x25, 0x73, 0x5c, 0x6e, 0x22, 0x2c, 0x20, 0x68, 0x6c, 0x5b,
+					0x30, 0x5d, 0x2c, 0x20, 0x68, 0x6c, 0x5b, 0x31, 0x5d, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x63, 0x20,
+					0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74,
+					0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x6c, 0x6e, 0x28, 0x77, 0x2c,
+					0x20, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69,
+					0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+					0x73, 0x7d, 0x7d, 0x0a, 0x2f, 0x2f, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+					0x74, 0x4d, 0x61, 0x73, 0x6b, 0x20, 0x69, 0x73, 0x20, 0x73, 0x68, 0x6f,
+					0x77, 0x6e, 0x20, 0x69, 0x6e, 0x20, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x20,
+					0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x73, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20,
+					0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+					0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x20,
+					0x3d, 0x20, 0x22, 0x2a, 0x2a, 0x2a, 0x2a, 0x22, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x6d, 0x61, 0x73, 0x6b, 0x45, 0x61, 0x63, 0x68, 0x20, 0x63, 0x61,
+					0x6c, 0x6c, 0x73, 0x20, 0x6d, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74,
+					0x68, 0x20, 0x76, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73,
+					0x20, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x69, 0x66,
+					0x20, 0x69, 0x74, 0x27, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x73, 0x6b, 0x45, 0x61,
+					0x63, 0x68, 0x28, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x6d, 0x61, 0x73, 0x6b, 0x20, 0x66,
+					0x75, 0x6e, 0x63, 0x28, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x29, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x6c, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x76, 0x2e, 0x28,
+					0x5b, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x29, 0x3b, 0x20, 0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66,
+					0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
+					0x6d, 0x61, 0x73, 0x6b, 0x28, 0x65, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x6d, 0x61, 0x73, 0x6b, 0x28, 0x76, 0x29, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x2f, 0x2f, 0x20, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x61, 0x66, 0x74, 0x65,
+					0x72, 0x20, 0x69, 0x74, 0x73, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61,
+					0x74, 0x68, 0x28, 0x70, 0x61, 0x74, 0x68, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x69, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+					0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x6e, 0x79, 0x28, 0x70, 0x61,
+					0x74, 0x68, 0x2c, 0x20, 0x22, 0x2e, 0x5b, 0x22, 0x29, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x69, 0x20, 0x3c, 0x20, 0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2c,
+					0x20, 0x22, 0x22, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+					0x2c, 0x20, 0x72, 0x65, 0x73, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x5b, 0x3a, 0x69, 0x5d, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68,
+					0x5b, 0x69, 0x3a, 0x5d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x72, 0x65, 0x73,
+					0x74, 0x5b, 0x30, 0x5d, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x5b, 0x27, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x6a, 0x20, 0x3a, 0x3d, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+					0x78, 0x42, 0x79, 0x74, 0x65, 0x28, 0x72, 0x65, 0x73, 0x74, 0x2c, 0x20,
+					0x27, 0x5d, 0x27, 0x29, 0x3b, 0x20, 0x6a, 0x20, 0x3e, 0x3d, 0x20, 0x30,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x73, 0x74, 0x20, 0x3d,
+					0x20, 0x72, 0x65, 0x73, 0x74, 0x5b, 0x6a, 0x2b, 0x31, 0x3a, 0x5d, 0x0a,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x73, 0x2e, 0x54, 0x72, 0x69, 0x6d, 0x50, 0x72, 0x65,
+					0x66, 0x69, 0x78, 0x28, 0x72, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x22, 0x2e,
+					0x22, 0x29, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x24, 0x6e, 0x2c, 0x20, 0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e,
+					0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x24, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+					0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x7d, 0x7d, 0x0a, 0x7b,
+					0x7b, 0x24, 0x74, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x49, 0x6d, 0x70, 0x6c,
+					0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x65,
+					0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+					0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
+					0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a,
+					0x09, 0x72, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65,
+					0x28, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x30,
+					0x2c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x29, 0x29, 0x0a, 0x09, 0x66,
+					0x6f, 0x72, 0x20, 0x68, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x48, 0x6f,
+					0x73, 0x74, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x73, 0x20,
+					0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x72, 0x65, 0x73,
+					0x2c, 0x20, 0x68, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x73, 0x6f, 0x72,
+					0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x28, 0x72, 0x65,
+					0x73, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72,
+					0x65, 0x73, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x77, 0x72, 0x69,
+					0x74, 0x65, 0x59, 0x41, 0x4d, 0x4c, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+					0x64, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x20, 0x76, 0x20, 0x61, 0x73, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x59, 0x41, 0x4d,
+					0x4c, 0x28, 0x77, 0x20, 0x69, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+					0x72, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x74, 0x76, 0x20, 0x3a, 0x3d,
+					0x20, 0x76, 0x2e, 0x28, 0x74, 0x79, 0x70, 0x65, 0x29, 0x20, 0x7b, 0x0a,
+					0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x3a, 0x0a, 0x09, 0x09, 0x6b, 0x65, 0x79, 0x73,
+					0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28, 0x5b, 0x5d, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x30, 0x2c, 0x20, 0x6c, 0x65,
+					0x6e, 0x28, 0x74, 0x76, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x66, 0x6f, 0x72,
+					0x20, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x74, 0x76, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x6b, 0x65, 0x79, 0x73,
+					0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6b, 0x65,
+					0x79, 0x73, 0x2c, 0x20, 0x6b, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09,
+					0x09, 0x73, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x73, 0x28, 0x6b, 0x65, 0x79, 0x73, 0x29, 0x0a, 0x09, 0x09, 0x66, 0x6f,
+					0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74,
+					0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x25, 0x73, 0x25, 0x73, 0x3a, 0x22,
+					0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x79, 0x61,
+					0x6d, 0x6c, 0x4b, 0x65, 0x79, 0x28, 0x6b, 0x29, 0x29, 0x0a, 0x09, 0x09,
+					0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x59, 0x41, 0x4d, 0x4c, 0x43, 0x68,
+					0x69, 0x6c, 0x64, 0x28, 0x77, 0x2c, 0x20, 0x74, 0x76, 0x5b, 0x6b, 0x5d,
+					0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x29, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x5b, 0x5d, 0x69, 0x6e,
+					0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x3a, 0x0a, 0x09,
+					0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x69, 0x74, 0x65, 0x6d,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x76,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70,
+					0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x25, 0x73,
+					0x2d, 0x22, 0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x29, 0x0a,
+					0x09, 0x09, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x59, 0x41, 0x4d, 0x4c,
+					0x43, 0x68, 0x69, 0x6c, 0x64, 0x28, 0x77, 0x2c, 0x20, 0x69, 0x74, 0x65,
+					0x6d, 0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x29, 0x0a, 0x09,
+					0x09, 0x7d, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a,
+					0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e,
+					0x74, 0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x25, 0x73, 0x25, 0x73, 0x5c,
+					0x6e, 0x22, 0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x2c, 0x20,
+					0x79, 0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x28, 0x76,
+					0x29, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x77, 0x72, 0x69, 0x74, 0x65, 0x59, 0x41, 0x4d, 0x4c, 0x43, 0x68, 0x69,
+					0x6c, 0x64, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61,
+					0x20, 0x6d, 0x61, 0x70, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x6f,
+					0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x2c,
+					0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x6f,
+					0x72, 0x20, 0x2d, 0x20, 0x68, 0x61, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65,
+					0x61, 0x64, 0x79, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x77, 0x72, 0x69,
+					0x74, 0x74, 0x65, 0x6e, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x77, 0x72,
+					0x69, 0x74, 0x65, 0x59, 0x41, 0x4d, 0x4c, 0x43, 0x68, 0x69, 0x6c, 0x64,
+					0x28, 0x77, 0x20, 0x69, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72,
+					0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x73,
+					0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x74, 0x76, 0x20, 0x3a, 0x3d, 0x20,
+					0x76, 0x2e, 0x28, 0x74, 0x79, 0x70, 0x65, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x63, 0x61, 0x73, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x3a, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x6c, 0x65,
+					0x6e, 0x28, 0x74, 0x76, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69,
+					0x6e, 0x74, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x20, 0x7b, 0x7d, 0x5c, 0x6e,
+					0x22, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46,
+					0x70, 0x72, 0x69, 0x6e, 0x74, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x5c, 0x6e,
+					0x22, 0x29, 0x0a, 0x09, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x59, 0x41,
+					0x4d, 0x4c, 0x28, 0x77, 0x2c, 0x20, 0x74, 0x76, 0x2c, 0x20, 0x69, 0x6e,
+					0x64, 0x65, 0x6e, 0x74, 0x2b, 0x22, 0x20, 0x20, 0x22, 0x29, 0x0a, 0x09,
+					0x63, 0x61, 0x73, 0x65, 0x20, 0x5b, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+					0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x3a, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x6c, 0x65, 0x6e, 0x28, 0x74, 0x76, 0x29, 0x20, 0x3d, 0x3d, 0x20,
+					0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46,
+					0x70, 0x72, 0x69, 0x6e, 0x74, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x20, 0x5b,
+					0x5d, 0x5c, 0x6e, 0x22, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x66, 0x6d,
+					0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x28, 0x77, 0x2c, 0x20,
+					0x22, 0x5c, 0x6e, 0x22, 0x29, 0x0a, 0x09, 0x09, 0x77, 0x72, 0x69, 0x74,
+					0x65, 0x59, 0x41, 0x4d, 0x4c, 0x28, 0x77, 0x2c, 0x20, 0x74, 0x76, 0x2c,
+					0x20, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x2b, 0x22, 0x20, 0x20, 0x22,
+					0x29, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x0a,
+					0x09, 0x09, 0x66, 0x6d, 0x74, 0x2e, 0x46, 0x70, 0x72, 0x69, 0x6e, 0x74,
+					0x66, 0x28, 0x77, 0x2c, 0x20, 0x22, 0x20, 0x25, 0x73, 0x5c, 0x6e, 0x22,
+					0x2c, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+					0x28, 0x76, 0x29, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x4b, 0x65, 0x79, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6b, 0x65,
+					0x79, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x65,
+					0x6e, 0x74, 0x72, 0x79, 0x2c, 0x20, 0x69, 0x74, 0x73, 0x20, 0x71, 0x75,
+					0x6f, 0x74, 0x65, 0x64, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20,
+					0x69, 0x74, 0x73, 0x20, 0x61, 0x20, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x20,
+					0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x79, 0x61,
+					0x6d, 0x6c, 0x4b, 0x65, 0x79, 0x28, 0x6b, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b,
+					0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6b, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x69, 0x66, 0x20, 0x21, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64,
+					0x65, 0x2e, 0x49, 0x73, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x28, 0x72,
+					0x29, 0x20, 0x26, 0x26, 0x20, 0x21, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64,
+					0x65, 0x2e, 0x49, 0x73, 0x44, 0x69, 0x67, 0x69, 0x74, 0x28, 0x72, 0x29,
+					0x20, 0x26, 0x26, 0x20, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x5f, 0x27,
+					0x20, 0x26, 0x26, 0x20, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x2d, 0x27,
+					0x20, 0x26, 0x26, 0x20, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x2e, 0x27,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x79, 0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x28,
+					0x6b, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6b, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x79, 0x61, 0x6d, 0x6c, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20,
+					0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x2c, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x61,
+					0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64,
+					0x20, 0x79, 0x61, 0x6d, 0x6c, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x79,
+					0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x28, 0x76, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x09, 0x62,
+					0x2c, 0x20, 0x5f, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x76, 0x29, 0x0a, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x28, 0x62, 0x29, 0x0a, 0x7d, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "cli.go.template",
+					size:    11527,
+					modTime: time.Unix(0, 1792429290395443614),
+					isDir:   false,
+				},
+			}, "/config.go.template": {
 				data: []byte{
 					0x2f, 0x2f, 0x20, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x7b,
 					0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d,
@@ -185,177 +1155,250 @@ func init() {
 					0x6f, 0x72, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
 					0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x63, 0x6f,
 					0x6e, 0x66, 0x69, 0x67, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x0a,
-					0x2f, 0x2f, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61,
-					0x6c, 0x6c, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x70, 0x72, 0x6f,
-					0x76, 0x69, 0x64, 0x65, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69,
-					0x66, 0x69, 0x63, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-					0x20, 0x69, 0x66, 0x20, 0x69, 0x74, 0x20, 0x63, 0x68, 0x6f, 0x6f, 0x73,
-					0x65, 0x73, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x77, 0x69, 0x73,
-					0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x20, 0x77, 0x69, 0x6c, 0x6c, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x61, 0x29,
-					0x20, 0x6c, 0x6f, 0x6f, 0x6b, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20,
-					0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-					0x6e, 0x65, 0x6d, 0x6e, 0x74, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
-					0x6c, 0x65, 0x2c, 0x20, 0x69, 0x66, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74,
-					0x6f, 0x20, 0x73, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x2c,
-					0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65,
-					0x64, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x62, 0x29, 0x20, 0x6c, 0x6f, 0x6f,
-					0x6b, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x53, 0x20,
-					0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73,
-					0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x2f, 0x2f, 0x0a, 0x2f, 0x2f, 0x0a,
-					0x2f, 0x2f, 0x20, 0x2a, 0x2a, 0x2a, 0x20, 0x54, 0x48, 0x49, 0x53, 0x20,
-					0x49, 0x53, 0x20, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44,
-					0x20, 0x43, 0x4f, 0x44, 0x45, 0x3a, 0x20, 0x44, 0x4f, 0x20, 0x4e, 0x4f,
-					0x54, 0x20, 0x45, 0x44, 0x49, 0x54, 0x20, 0x2a, 0x2a, 0x2a, 0x0a, 0x70,
-					0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x50, 0x61,
-					0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a,
-					0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x22,
+					0x2f, 0x2f, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+					0x20, 0x20, 0x3a, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x61, 0x6c, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20,
+					0x6c, 0x61, 0x62, 0x65, 0x6c, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+					0x6f, 0x72, 0x73, 0x20, 0x5b, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x65, 0x6e,
+					0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x3a, 0x20,
+					0x70, 0x72, 0x6f, 0x64, 0x5d, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x73,
+					0x65, 0x6c, 0x65, 0x63, 0x74, 0x20, 0x61, 0x20, 0x6e, 0x61, 0x6d, 0x65,
+					0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x74, 0x68, 0x69,
+					0x73, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x20,
+					0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73,
+					0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20,
+					0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x66, 0x75, 0x6c, 0x2c, 0x20,
+					0x65, 0x2e, 0x67, 0x2e, 0x20, 0x69, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x74,
+					0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x0a, 0x2f, 0x2f, 0x0a, 0x2f, 0x2f,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20,
+					0x63, 0x61, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x20,
+					0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x66, 0x20, 0x69,
+					0x74, 0x20, 0x63, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x73, 0x2c, 0x20, 0x6f,
+					0x74, 0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x77, 0x69, 0x6c, 0x6c,
+					0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x61, 0x29, 0x20, 0x6c, 0x6f, 0x6f, 0x6b,
+					0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+					0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x65, 0x6d, 0x6e, 0x74,
+					0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x2c, 0x20, 0x69,
+					0x66, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x6f, 0x6d,
+					0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x74, 0x68, 0x61, 0x74,
+					0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x0a, 0x2f, 0x2f, 0x20,
+					0x20, 0x62, 0x29, 0x20, 0x6c, 0x6f, 0x6f, 0x6b, 0x20, 0x61, 0x74, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x4f, 0x53, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c,
+					0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x0a, 0x2f, 0x2f, 0x0a, 0x2f, 0x2f, 0x0a, 0x2f, 0x2f, 0x20, 0x2a, 0x2a,
+					0x2a, 0x20, 0x54, 0x48, 0x49, 0x53, 0x20, 0x49, 0x53, 0x20, 0x47, 0x45,
+					0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44, 0x20, 0x43, 0x4f, 0x44, 0x45,
+					0x3a, 0x20, 0x44, 0x4f, 0x20, 0x4e, 0x4f, 0x54, 0x20, 0x45, 0x44, 0x49,
+					0x54, 0x20, 0x2a, 0x2a, 0x2a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+					0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x65, 0x6e, 0x3a, 0x68, 0x61, 0x73, 0x68,
+					0x20, 0x7b, 0x7b, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x7d, 0x7d, 0x0a, 0x0a,
+					0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x22, 0x62,
+					0x79, 0x74, 0x65, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x65, 0x6e, 0x63, 0x6f,
+					0x64, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x0a, 0x09,
+					0x22, 0x66, 0x6d, 0x74, 0x22, 0x0a, 0x09, 0x22, 0x69, 0x6f, 0x22, 0x0a,
+					0x09, 0x22, 0x69, 0x6f, 0x2f, 0x66, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x6f,
+					0x73, 0x22, 0x0a, 0x09, 0x22, 0x70, 0x61, 0x74, 0x68, 0x22, 0x0a, 0x09,
+					0x22, 0x70, 0x61, 0x74, 0x68, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61,
+					0x74, 0x68, 0x22, 0x0a, 0x09, 0x22, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x0a,
+					0x09, 0x22, 0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x22, 0x0a, 0x09,
+					0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x0a, 0x09, 0x22,
+					0x73, 0x79, 0x6e, 0x63, 0x22, 0x0a, 0x09, 0x22, 0x73, 0x79, 0x6e, 0x63,
+					0x2f, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x22, 0x0a, 0x09, 0x22, 0x74,
+					0x69, 0x6d, 0x65, 0x22, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+					0x74, 0x73, 0x20, 0x22, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x20, 0x22,
 					0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x73, 0x6f,
-					0x6e, 0x22, 0x0a, 0x09, 0x22, 0x66, 0x6d, 0x74, 0x22, 0x0a, 0x09, 0x22,
-					0x6f, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x70, 0x61, 0x74, 0x68, 0x2f, 0x66,
-					0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x22, 0x0a, 0x09, 0x22, 0x73,
-					0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x74, 0x69,
-					0x6d, 0x65, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f,
-					0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x64,
-					0x65, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6e,
-					0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x74,
-					0x6f, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20,
-					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20,
-					0x4c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63,
-					0x20, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-					0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20,
-					0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
-					0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a, 0x0a, 0x2f, 0x2f,
-					0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20,
-					0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x70,
-					0x65, 0x63, 0x69, 0x66, 0x79, 0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74,
-					0x6f, 0x6d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x0a, 0x76, 0x61,
-					0x72, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72,
-					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e,
-					0x63, 0x20, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e,
-					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73,
-					0x20, 0x61, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x6f, 0x66,
-					0x20, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x20, 0x69, 0x74, 0x73, 0x20, 0x74,
-					0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x73, 0x20, 0x74,
-					0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-					0x0a, 0x2f, 0x2f, 0x20, 0x62, 0x75, 0x74, 0x20, 0x73, 0x75, 0x70, 0x70,
-					0x6f, 0x72, 0x74, 0x73, 0x20, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x20,
-					0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20,
-					0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x0a, 0x74, 0x79,
-					0x70, 0x65, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x6e, 0x22, 0x20, 0x22, 0x66, 0x6d, 0x74, 0x22, 0x20, 0x22, 0x69, 0x6f,
+					0x22, 0x20, 0x22, 0x69, 0x6f, 0x2f, 0x66, 0x73, 0x22, 0x20, 0x22, 0x6f,
+					0x73, 0x22, 0x20, 0x22, 0x70, 0x61, 0x74, 0x68, 0x22, 0x20, 0x22, 0x70,
+					0x61, 0x74, 0x68, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68,
+					0x22, 0x20, 0x22, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x20, 0x22, 0x73, 0x74,
+					0x72, 0x63, 0x6f, 0x6e, 0x76, 0x22, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x73, 0x22, 0x20, 0x22, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x20,
+					0x22, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63,
+					0x22, 0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x7d, 0x7d, 0x0a, 0x09,
+					0x22, 0x7b, 0x7b, 0x2e, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f, 0x61,
+					0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x64, 0x65,
+					0x66, 0x69, 0x6e, 0x65, 0x73, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6e, 0x63,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x74, 0x6f,
+					0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x66, 0x69, 0x6c, 0x65, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66,
+					0x20, 0x6f, 0x72, 0x20, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x52,
+					0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d, 0x2c, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x0a, 0x2f, 0x2f, 0x20,
+					0x66, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6c, 0x6f,
+					0x61, 0x64, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x61, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73,
+					0x61, 0x67, 0x65, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x64, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20,
+					0x74, 0x68, 0x65, 0x79, 0x27, 0x72, 0x65, 0x20, 0x64, 0x65, 0x63, 0x6f,
+					0x64, 0x65, 0x64, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a,
+					0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4a, 0x53, 0x4f, 0x4e,
+					0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+					0x73, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x79,
+					0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x6c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x0a, 0x2f, 0x2f, 0x0a, 0x2f, 0x2f, 0x20, 0x44,
+					0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x3a, 0x20, 0x75,
+					0x73, 0x65, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x53, 0x4f, 0x4e,
+					0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x2c, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x64, 0x6f, 0x65,
+					0x73, 0x6e, 0x27, 0x74, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65,
+					0x0a, 0x76, 0x61, 0x72, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61,
+					0x64, 0x65, 0x72, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e,
+					0x46, 0x75, 0x6e, 0x63, 0x20, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a,
+					0x53, 0x4f, 0x4e, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x44, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65,
+					0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+					0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x20, 0x69, 0x74,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61,
+					0x73, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x0a, 0x2f, 0x2f, 0x20, 0x62, 0x75, 0x74, 0x20, 0x73,
+					0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x62, 0x65, 0x74, 0x74,
+					0x65, 0x72, 0x20, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x69,
+					0x6e, 0x67, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+					0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x55, 0x6e, 0x6d,
+					0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x68,
+					0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64,
+					0x69, 0x6e, 0x67, 0x20, 0x6f, 0x75, 0x72, 0x20, 0x63, 0x75, 0x73, 0x74,
+					0x6f, 0x6d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x72, 0x69,
+					0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x0a,
+					0x2f, 0x2f, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20,
+					0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+					0x74, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x73,
+					0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x6a, 0x73,
+					0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x74, 0x68,
+					0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x73, 0x2c, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64,
+					0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x20, 0x69, 0x6e, 0x64,
+					0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x65,
+					0x2e, 0x67, 0x2e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63, 0x61, 0x6e,
+					0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x3a,
+					0x31, 0x30, 0x30, 0x20, 0x61, 0x73, 0x20, 0x77, 0x65, 0x6c, 0x6c, 0x20,
+					0x61, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x3a, 0x22, 0x31, 0x30, 0x6d, 0x22,
+					0x2c, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x69, 0x67,
+					0x6e, 0x6f, 0x72, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28,
+					0x64, 0x20, 0x2a, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29,
+					0x20, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4a, 0x53,
+					0x4f, 0x4e, 0x28, 0x62, 0x20, 0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x29,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x29, 0x20, 0x3d,
+					0x3d, 0x20, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x62, 0x5b, 0x30, 0x5d, 0x20,
+					0x3d, 0x3d, 0x20, 0x27, 0x22, 0x27, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x64,
+					0x69, 0x72, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x74,
+					0x69, 0x6d, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x44, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x28, 0x62, 0x5b, 0x31, 0x20, 0x3a, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x62,
+					0x29, 0x2d, 0x31, 0x5d, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x2a, 0x64, 0x20,
+					0x3d, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x64,
+					0x69, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x28, 0x62, 0x29, 0x29, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x28,
+					0x29, 0x0a, 0x09, 0x2a, 0x64, 0x20, 0x3d, 0x20, 0x44, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x69, 0x29, 0x20, 0x2a, 0x20,
+					0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x29,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68,
+					0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x73, 0x20, 0x6f, 0x75, 0x72, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f,
+					0x6d, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x76,
+					0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x71, 0x75,
+					0x6f, 0x74, 0x65, 0x64, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x75, 0x6e, 0x64, 0x65,
+					0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x27, 0x73, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20,
+					0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68,
+					0x69, 0x73, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x79, 0x6f, 0x75,
+					0x20, 0x67, 0x65, 0x74, 0x20, 0x61, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x74,
+					0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x6e, 0x69, 0x74,
+					0x73, 0x20, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2c,
+					0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x22, 0x31, 0x30, 0x6d, 0x30, 0x73,
+					0x22, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x64, 0x20, 0x44, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x4d, 0x61, 0x72, 0x73,
+					0x68, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x29, 0x20, 0x28, 0x5b,
+					0x5d, 0x62, 0x79, 0x74, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x28, 0x60, 0x22, 0x60, 0x20, 0x2b,
+					0x20, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20,
+					0x2b, 0x20, 0x60, 0x22, 0x60, 0x29, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+					0x74, 0x65, 0x64, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20,
+					0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c,
+					0x75, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x6d,
+					0x61, 0x74, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x35, 0x6d, 0x30,
+					0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x35, 0x20, 0x6d, 0x69, 0x6e, 0x75,
+					0x74, 0x65, 0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x64, 0x20,
+					0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x53, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
 					0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73,
-					0x68, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x68, 0x61, 0x6e, 0x64,
-					0x6c, 0x65, 0x73, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-					0x20, 0x6f, 0x75, 0x72, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20,
-					0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
-					0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x44,
-					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x0a, 0x2f, 0x2f, 0x20,
-					0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
-					0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x75, 0x6d,
-					0x62, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x72, 0x65,
-					0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x73, 0x65, 0x63, 0x6f,
-					0x6e, 0x64, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20,
-					0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
-					0x61, 0x72, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2c,
-					0x20, 0x63, 0x61, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65,
-					0x20, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x20, 0x74, 0x69,
-					0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-					0x75, 0x6e, 0x69, 0x74, 0x73, 0x20, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61,
-					0x74, 0x6f, 0x72, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x65, 0x2e, 0x67, 0x2e,
-					0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x64, 0x65,
-					0x63, 0x6f, 0x64, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x3a, 0x31, 0x30, 0x30,
-					0x20, 0x61, 0x73, 0x20, 0x77, 0x65, 0x6c, 0x6c, 0x20, 0x61, 0x73, 0x20,
-					0x76, 0x61, 0x6c, 0x3a, 0x22, 0x31, 0x30, 0x6d, 0x22, 0x0a, 0x66, 0x75,
-					0x6e, 0x63, 0x20, 0x28, 0x64, 0x20, 0x2a, 0x44, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x29, 0x20, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68,
-					0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x62, 0x20, 0x5b, 0x5d, 0x62,
-					0x79, 0x74, 0x65, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b,
-					0x0a, 0x09, 0x69, 0x66, 0x20, 0x62, 0x5b, 0x30, 0x5d, 0x20, 0x3d, 0x3d,
-					0x20, 0x27, 0x22, 0x27, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x64, 0x69, 0x72,
-					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x74, 0x69, 0x6d,
-					0x65, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62,
-					0x5b, 0x31, 0x20, 0x3a, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x62, 0x29, 0x2d,
-					0x31, 0x5d, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x2a, 0x64, 0x20, 0x3d, 0x20,
-					0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x64, 0x69, 0x72,
-					0x29, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65,
-					0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x2c, 0x20, 0x65, 0x72,
-					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x75,
-					0x6d, 0x62, 0x65, 0x72, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28,
-					0x62, 0x29, 0x29, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x28, 0x29, 0x0a,
-					0x09, 0x2a, 0x64, 0x20, 0x3d, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-					0x6f, 0x6e, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61,
-					0x74, 0x69, 0x6f, 0x6e, 0x28, 0x69, 0x29, 0x20, 0x2a, 0x20, 0x74, 0x69,
-					0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x29, 0x0a, 0x09,
-					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x7d,
-					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
-					0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x73,
-					0x20, 0x6f, 0x75, 0x72, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20,
-					0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c,
-					0x75, 0x65, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x71, 0x75, 0x6f, 0x74,
-					0x65, 0x64, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
-					0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c,
-					0x79, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x27, 0x73,
-					0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20, 0x6f, 0x75,
-					0x74, 0x70, 0x75, 0x74, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x69, 0x73,
-					0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x79, 0x6f, 0x75, 0x20, 0x67,
-					0x65, 0x74, 0x20, 0x61, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x74, 0x72, 0x61,
-					0x69, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x20,
-					0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2c, 0x20, 0x65,
-					0x2e, 0x67, 0x2e, 0x20, 0x22, 0x31, 0x30, 0x6d, 0x30, 0x73, 0x22, 0x0a,
-					0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x64, 0x20, 0x44, 0x75, 0x72, 0x61,
-					0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61,
-					0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x29, 0x20, 0x28, 0x5b, 0x5d, 0x62,
-					0x79, 0x74, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
-					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x5b, 0x5d,
-					0x62, 0x79, 0x74, 0x65, 0x28, 0x60, 0x22, 0x60, 0x20, 0x2b, 0x20, 0x64,
-					0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20, 0x2b, 0x20,
-					0x60, 0x22, 0x60, 0x29, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x2f, 0x2f, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x74, 0x72,
-					0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65,
-					0x64, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
-					0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
-					0x55, 0x6e, 0x69, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-					0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x35, 0x6d, 0x30, 0x73, 0x20,
-					0x66, 0x6f, 0x72, 0x20, 0x35, 0x20, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-					0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x64, 0x20, 0x44, 0x75,
-					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x53, 0x74, 0x72, 0x69,
-					0x6e, 0x67, 0x28, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20,
-					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x69,
-					0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28,
-					0x64, 0x29, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x0a,
-					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x75,
-					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x73, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x64, 0x75, 0x72, 0x61,
-					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x74, 0x69,
-					0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-					0x74, 0x79, 0x70, 0x65, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x64,
-					0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x54,
-					0x69, 0x6d, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28,
-					0x29, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x28, 0x64, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b,
-					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x6e, 0x2c, 0x20, 0x24,
-					0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-					0x73, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x50,
-					0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-					0x6e, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b,
-					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
-					0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69,
-					0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x24,
-					0x66, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x43, 0x6f,
-					0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b,
-					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x7b, 0x7b,
-					0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61,
-					0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x6e, 0x28, 0x64, 0x29, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28,
+					0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x54, 0x69, 0x6d, 0x65,
+					0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x64, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20,
+					0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x28, 0x64, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29,
+					0x20, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x28, 0x29, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x64, 0x29, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x6e, 0x2c,
+					0x20, 0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75,
+					0x63, 0x74, 0x73, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x24, 0x74,
+					0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+					0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65,
+					0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x20, 0x73, 0x74, 0x72, 0x75,
+					0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x72, 0x61, 0x6e,
+					0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64,
+					0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+					0x6e, 0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x44, 0x65, 0x70,
+					0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+					0x6e, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x24, 0x66, 0x2e, 0x49, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+					0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79,
+					0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x65,
+					0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x47, 0x6f,
+					0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x54, 0x61, 0x67, 0x7d, 0x7d, 0x7b, 0x7b, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
 					0x7d, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63,
 					0x20, 0x28, 0x63, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29,
 					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f,
@@ -365,349 +1408,3050 @@ func init() {
 					0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4f, 0x76, 0x65,
 					0x72, 0x72, 0x69, 0x64, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d, 0x0a,
 					0x09, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
-					0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69,
-					0x66, 0x20, 0x24, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x47, 0x65, 0x74,
-					0x74, 0x65, 0x72, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x47, 0x65,
-					0x74, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
-					0x7d, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
-					0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54,
-					0x79, 0x70, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63,
-					0x20, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d, 0x7d, 0x28, 0x64, 0x2c, 0x20,
-					0x6f, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-					0x7d, 0x7d, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x7b,
-					0x7b, 0x20, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-					0x65, 0x45, 0x78, 0x70, 0x72, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x0a, 0x09,
-					0x09, 0x09, 0x2a, 0x64, 0x20, 0x3d, 0x20, 0x2a, 0x6f, 0x0a, 0x09, 0x09,
-					0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
-					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x20, 0x77, 0x69,
-					0x6c, 0x6c, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x20, 0x74,
-					0x6f, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+					0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x43, 0x6c, 0x6f,
+					0x6e, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61,
+					0x20, 0x64, 0x65, 0x65, 0x70, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20, 0x6f,
+					0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20, 0x64,
+					0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65,
+					0x20, 0x61, 0x6e, 0x79, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x73, 0x6c, 0x69,
+					0x63, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x0a, 0x09, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x29, 0x20, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x29, 0x20,
+					0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x69, 0x66, 0x20, 0x63, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65,
+					0x73, 0x20, 0x3a, 0x3d, 0x20, 0x2a, 0x63, 0x0a, 0x09, 0x09, 0x7b, 0x7b,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20,
+					0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x7d,
+					0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x72, 0x65,
+					0x73, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+					0x74, 0x72, 0x75, 0x65, 0x20, 0x69, 0x66, 0x20, 0x6f, 0x74, 0x68, 0x65,
+					0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61,
+					0x6d, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x61, 0x73,
+					0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x20, 0x2a, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x29, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x69, 0x66, 0x20, 0x63, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7c, 0x7c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x3d, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x20, 0x3d, 0x3d, 0x20, 0x6f, 0x74,
+					0x68, 0x65, 0x72, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b,
+					0x24, 0x74, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c,
+					0x7d, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x44,
+					0x69, 0x66, 0x66, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+					0x74, 0x20, 0x69, 0x6e, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2c, 0x0a,
+					0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+					0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x28, 0x63, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29,
+					0x20, 0x44, 0x69, 0x66, 0x66, 0x28, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20,
+					0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x5b, 0x5d, 0x43,
+					0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x76, 0x61,
+					0x72, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x5b, 0x5d,
+					0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20,
+					0x63, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7c, 0x7c, 0x20,
+					0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x63, 0x20, 0x21,
+					0x3d, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x3d, 0x20,
+					0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x63, 0x68, 0x61, 0x6e, 0x67,
+					0x65, 0x73, 0x2c, 0x20, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x7b, 0x50,
+					0x61, 0x74, 0x68, 0x3a, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x4f, 0x6c, 0x64,
+					0x3a, 0x20, 0x63, 0x2c, 0x20, 0x4e, 0x65, 0x77, 0x3a, 0x20, 0x6f, 0x74,
+					0x68, 0x65, 0x72, 0x7d, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x73, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x63,
+					0x2e, 0x64, 0x69, 0x66, 0x66, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x6f, 0x74,
+					0x68, 0x65, 0x72, 0x2c, 0x20, 0x26, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+					0x73, 0x29, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x0a, 0x09, 0x7d, 0x0a, 0x0a,
+					0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x20, 0x2a, 0x7b, 0x7b,
+					0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x64, 0x69, 0x66, 0x66, 0x28, 0x70,
+					0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x2c, 0x20, 0x6f, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2c,
+					0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x2a, 0x5b, 0x5d,
+					0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a,
+					0x3d, 0x20, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6d, 0x70, 0x6c,
+					0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x47, 0x65, 0x74,
+					0x50, 0x61, 0x74, 0x68, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61,
+					0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20,
+					0x65, 0x2e, 0x67, 0x2e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x53, 0x65,
+					0x72, 0x76, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x2e, 0x43, 0x65, 0x72, 0x74,
+					0x46, 0x69, 0x6c, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x4c, 0x6f, 0x67, 0x4c,
+					0x65, 0x76, 0x65, 0x6c, 0x73, 0x5b, 0x30, 0x5d, 0x2e, 0x4c, 0x65, 0x76,
+					0x65, 0x6c, 0x2c, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x6d, 0x61,
+					0x70, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
+					0x73, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72,
+					0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x42,
+					0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+					0x55, 0x52, 0x4c, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63,
+					0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x47, 0x65,
+					0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x70, 0x61, 0x74, 0x68, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x63, 0x2e, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+					0x28, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28,
+					0x63, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x67,
+					0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c,
+					0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x20, 0x28, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+					0x7b, 0x7d, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x69, 0x64, 0x78,
+					0x2c, 0x20, 0x72, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68,
+					0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x29,
+					0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x73, 0x77, 0x69, 0x74,
+					0x63, 0x68, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a,
+					0x3d, 0x20, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x49,
+					0x6d, 0x70, 0x6c, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x66, 0x69,
+					0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x66, 0x75, 0x6c,
+					0x6c, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x22, 0x2c,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09,
+					0x2f, 0x2f, 0x20, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x20, 0x70,
+					0x61, 0x72, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6f, 0x66,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x61,
+					0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20,
+					0x61, 0x6e, 0x64, 0x20, 0x73, 0x65, 0x74, 0x73, 0x20, 0x69, 0x74, 0x2c,
+					0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x20,
+					0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x66,
+					0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x61,
+					0x72, 0x72, 0x61, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x63, 0x6f,
+					0x6d, 0x6d, 0x61, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65,
+					0x64, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20,
+					0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+					0x20, 0x61, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x6f, 0x62, 0x6a, 0x65,
+					0x63, 0x74, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x20,
+					0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x53, 0x65, 0x74,
+					0x50, 0x61, 0x74, 0x68, 0x28, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x76,
+					0x61, 0x6c, 0x75, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x2e, 0x73, 0x65, 0x74, 0x50,
+					0x61, 0x74, 0x68, 0x28, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x20,
+					0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x73, 0x65, 0x74,
+					0x50, 0x61, 0x74, 0x68, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x70,
+					0x61, 0x74, 0x68, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x69,
+					0x64, 0x78, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61,
+					0x74, 0x68, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x70, 0x61, 0x74,
+					0x68, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09,
+					0x09, 0x7d, 0x0a, 0x09, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x49, 0x6d, 0x70, 0x6c,
+					0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x22, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x57, 0x61, 0x6c, 0x6b, 0x20,
+					0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x66, 0x6e, 0x20, 0x77, 0x69, 0x74,
+					0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x61,
+					0x6e, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20,
+					0x65, 0x61, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2c, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x6f,
+					0x66, 0x20, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2c, 0x20, 0x61, 0x6e,
+					0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6c, 0x65,
+					0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x6c, 0x69,
+					0x63, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x61, 0x70, 0x73,
+					0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x77, 0x61, 0x6c, 0x6b, 0x65, 0x64, 0x20, 0x69,
+					0x6e, 0x74, 0x6f, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63,
+					0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x57, 0x61,
+					0x6c, 0x6b, 0x28, 0x66, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x70,
+					0x61, 0x74, 0x68, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20,
+					0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x29, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x63, 0x2e, 0x77, 0x61,
+					0x6c, 0x6b, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x66, 0x6e, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x20,
+					0x2a, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x20, 0x77, 0x61, 0x6c,
+					0x6b, 0x28, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x2c, 0x20, 0x66, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63,
+					0x28, 0x70, 0x61, 0x74, 0x68, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x29, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x7b, 0x7b,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20,
+					0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d,
+					0x0a, 0x09, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09,
+					0x7d, 0x0a, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x24, 0x74, 0x2e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+					0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+					0x6d, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6d, 0x70,
+					0x6c, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x48,
+					0x61, 0x73, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x44, 0x65, 0x70,
+					0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6d, 0x70,
+					0x6c, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x48,
+					0x61, 0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x24,
+					0x74, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x53, 0x65, 0x74, 0x49, 0x6d,
+					0x70, 0x6c, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74,
+					0x2e, 0x57, 0x69, 0x74, 0x68, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x7d,
+					0x7d, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72,
+					0x73, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x24, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64,
+					0x65, 0x72, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x42, 0x75, 0x69,
+					0x6c, 0x64, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65,
+					0x74, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x53,
+					0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x42, 0x61, 0x73, 0x65,
+					0x54, 0x79, 0x70, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x09, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d, 0x7d, 0x28, 0x64, 0x2c,
+					0x20, 0x6f, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20,
+					0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x45, 0x78, 0x70, 0x72, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x20,
+					0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09,
+					0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x46,
+					0x75, 0x6e, 0x63, 0x49, 0x6d, 0x70, 0x6c, 0x20, 0x7d, 0x7d, 0x0a, 0x0a,
+					0x09, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x46, 0x75, 0x6e, 0x63, 0x49, 0x6d, 0x70, 0x6c, 0x20, 0x7d, 0x7d, 0x0a,
+					0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66,
+					0x46, 0x75, 0x6e, 0x63, 0x49, 0x6d, 0x70, 0x6c, 0x20, 0x7d, 0x7d, 0x0a,
+					0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x73,
+					0x46, 0x75, 0x6e, 0x63, 0x49, 0x6d, 0x70, 0x6c, 0x20, 0x7d, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72,
+					0x69, 0x62, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x74, 0x68, 0x61, 0x74,
+					0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+					0x74, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x32, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x43, 0x68, 0x61, 0x6e,
+					0x67, 0x65, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a,
+					0x09, 0x2f, 0x2f, 0x20, 0x50, 0x61, 0x74, 0x68, 0x20, 0x69, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x74, 0x6f, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x65,
+					0x2e, 0x67, 0x2e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x53, 0x65, 0x72,
+					0x76, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x46,
+					0x69, 0x6c, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+					0x76, 0x65, 0x6c, 0x73, 0x5b, 0x31, 0x5d, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+					0x6c, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x68, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x4f, 0x6c, 0x64, 0x20, 0x69,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x69,
+					0x6e, 0x61, 0x6c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x69,
+					0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x77, 0x61, 0x73, 0x20, 0x61, 0x64, 0x64, 0x65, 0x64, 0x0a, 0x09, 0x4f,
+					0x6c, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+					0x7b, 0x7d, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x4e, 0x65, 0x77, 0x20, 0x69,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x69, 0x66, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x61, 0x73,
+					0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x0a, 0x09, 0x4e, 0x65,
+					0x77, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x53, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61,
+					0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e,
+					0x67, 0x65, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x48, 0x54, 0x54,
+					0x50, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x3a, 0x20, 0x38, 0x30, 0x38, 0x30,
+					0x20, 0x2d, 0x3e, 0x20, 0x38, 0x30, 0x38, 0x31, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x28, 0x63, 0x20, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x29,
+					0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x53, 0x70, 0x72, 0x69, 0x6e,
+					0x74, 0x66, 0x28, 0x22, 0x25, 0x73, 0x3a, 0x20, 0x25, 0x76, 0x20, 0x2d,
+					0x3e, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x63, 0x2e, 0x50, 0x61, 0x74,
+					0x68, 0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x6c, 0x64, 0x2c, 0x20, 0x63, 0x2e,
+					0x4e, 0x65, 0x77, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x73,
+					0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x20, 0x69, 0x73,
+					0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e,
+					0x20, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20,
+					0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x20, 0x73, 0x65, 0x63, 0x72,
+					0x65, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x20, 0x3d, 0x20, 0x22, 0x2a, 0x2a,
+					0x2a, 0x2a, 0x22, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x62, 0x6f, 0x6f, 0x6c,
+					0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x2a, 0x62, 0x6f, 0x6f, 0x6c,
+					0x2c, 0x20, 0x6f, 0x72, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x69, 0x66, 0x20,
+					0x69, 0x74, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x65, 0x74, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+					0x75, 0x65, 0x28, 0x76, 0x20, 0x2a, 0x62, 0x6f, 0x6f, 0x6c, 0x29, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x20,
+					0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x76, 0x20, 0x3d, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x2a, 0x76, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x20,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x5b, 0x6f, 0x72, 0x20, 0x2d, 0x31,
+					0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x20, 0x69, 0x73,
+					0x6e, 0x27, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x5d, 0x20, 0x61, 0x6e, 0x64,
+					0x20, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x0a, 0x2f,
+					0x2f, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x66, 0x75,
+					0x6c, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e,
+					0x74, 0x69, 0x72, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x74, 0x68,
+					0x61, 0x74, 0x27, 0x73, 0x20, 0x62, 0x65, 0x69, 0x6e, 0x67, 0x20, 0x72,
+					0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x66,
+					0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x2c, 0x20, 0x69, 0x6e, 0x74, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b,
+					0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x74,
+					0x20, 0x3a, 0x3d, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x22, 0x22,
+					0x0a, 0x09, 0x69, 0x66, 0x20, 0x69, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42,
+					0x79, 0x74, 0x65, 0x28, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x27, 0x2e,
+					0x27, 0x29, 0x3b, 0x20, 0x69, 0x20, 0x3e, 0x3d, 0x20, 0x30, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x72, 0x65, 0x73,
+					0x74, 0x20, 0x3d, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x3a, 0x69, 0x5d,
+					0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x69, 0x2b, 0x31, 0x3a, 0x5d,
+					0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x72, 0x65, 0x73, 0x74, 0x20, 0x3d,
+					0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x2d, 0x31, 0x2c,
+					0x20, 0x22, 0x22, 0x2c, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x66, 0x28, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+					0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x25, 0x71, 0x2c, 0x20, 0x69, 0x74,
+					0x20, 0x65, 0x6e, 0x64, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61,
+					0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2c,
+					0x20, 0x66, 0x75, 0x6c, 0x6c, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x69, 0x64, 0x78, 0x20, 0x3a, 0x3d, 0x20, 0x2d, 0x31,
+					0x0a, 0x09, 0x69, 0x66, 0x20, 0x69, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42,
+					0x79, 0x74, 0x65, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x27, 0x5b,
+					0x27, 0x29, 0x3b, 0x20, 0x69, 0x20, 0x3e, 0x3d, 0x20, 0x30, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x2e, 0x41, 0x74, 0x6f,
+					0x69, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x54, 0x72,
+					0x69, 0x6d, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x28, 0x6e, 0x61, 0x6d,
+					0x65, 0x5b, 0x69, 0x2b, 0x31, 0x3a, 0x5d, 0x2c, 0x20, 0x22, 0x5d, 0x22,
+					0x29, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x6e, 0x20,
+					0x3c, 0x20, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x21, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x75, 0x66, 0x66, 0x69,
+					0x78, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x22, 0x5d, 0x22, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x22, 0x22, 0x2c, 0x20, 0x2d, 0x31, 0x2c, 0x20, 0x22, 0x22, 0x2c,
+					0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28,
+					0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x70, 0x61, 0x74,
+					0x68, 0x20, 0x25, 0x71, 0x2c, 0x20, 0x25, 0x73, 0x20, 0x68, 0x61, 0x73,
+					0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x20, 0x66, 0x75, 0x6c, 0x6c,
+					0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x69, 0x64, 0x78, 0x20,
+					0x3d, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x5b, 0x3a, 0x69, 0x5d, 0x2c, 0x20,
+					0x6e, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x2d,
+					0x31, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+					0x69, 0x64, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x25, 0x71, 0x2c, 0x20,
+					0x69, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6d,
+					0x70, 0x74, 0x79, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x22, 0x2c, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x2c, 0x20, 0x69, 0x64, 0x78, 0x2c, 0x20, 0x72, 0x65, 0x73,
+					0x74, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61,
+					0x70, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+					0x67, 0x20, 0x70, 0x61, 0x74, 0x68, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x73, 0x70, 0x6c, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x28, 0x70, 0x61, 0x74,
+					0x68, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x69, 0x20, 0x3a,
+					0x3d, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x6e,
+					0x64, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x28, 0x70, 0x61, 0x74, 0x68,
+					0x2c, 0x20, 0x27, 0x2e, 0x27, 0x29, 0x3b, 0x20, 0x69, 0x20, 0x3e, 0x3d,
+					0x20, 0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x3a, 0x69, 0x5d, 0x2c, 0x20,
+					0x70, 0x61, 0x74, 0x68, 0x5b, 0x69, 0x2b, 0x31, 0x3a, 0x5d, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x2c, 0x20, 0x22, 0x22, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x74, 0x79, 0x70, 0x65,
+					0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66,
+					0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x69,
+					0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20,
+					0x25, 0x71, 0x2c, 0x20, 0x25, 0x73, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e,
+					0x27, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x61, 0x20, 0x66, 0x69,
+					0x65, 0x6c, 0x64, 0x20, 0x25, 0x73, 0x22, 0x2c, 0x20, 0x66, 0x75, 0x6c,
+					0x6c, 0x2c, 0x20, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x2c,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x6c, 0x65, 0x61, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x66, 0x75, 0x6c, 0x6c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+					0x64, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x25, 0x71, 0x2c, 0x20, 0x69,
+					0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x73, 0x20,
+					0x70, 0x61, 0x73, 0x74, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6e, 0x6f,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x2c, 0x20, 0x66, 0x75,
+					0x6c, 0x6c, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x6e, 0x6f, 0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28,
+					0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x70, 0x61, 0x74,
+					0x68, 0x20, 0x25, 0x71, 0x2c, 0x20, 0x25, 0x73, 0x20, 0x69, 0x73, 0x20,
+					0x6e, 0x6f, 0x74, 0x20, 0x61, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x22,
+					0x2c, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65,
+					0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x66, 0x28, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20,
+					0x70, 0x61, 0x74, 0x68, 0x20, 0x25, 0x71, 0x2c, 0x20, 0x25, 0x73, 0x20,
+					0x69, 0x73, 0x20, 0x61, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x2c, 0x20,
+					0x61, 0x6e, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x69, 0x73, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
+					0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x66,
+					0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x2c, 0x20, 0x66, 0x75, 0x6c, 0x6c,
+					0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x66, 0x28, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20,
+					0x70, 0x61, 0x74, 0x68, 0x20, 0x25, 0x71, 0x2c, 0x20, 0x25, 0x73, 0x20,
+					0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x73, 0x65, 0x74, 0x22, 0x2c, 0x20,
+					0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x69, 0x6e, 0x64, 0x65,
+					0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x69, 0x64, 0x78, 0x2c,
+					0x20, 0x6c, 0x20, 0x69, 0x6e, 0x74, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22,
+					0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x70, 0x61, 0x74, 0x68,
+					0x20, 0x25, 0x71, 0x2c, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x25,
+					0x64, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f, 0x66, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x68, 0x61,
+					0x73, 0x20, 0x25, 0x64, 0x20, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+					0x73, 0x22, 0x2c, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x69, 0x64,
+					0x78, 0x2c, 0x20, 0x6c, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22,
+					0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x20, 0x25, 0x71, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x25, 0x73, 0x3a,
+					0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c,
+					0x20, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x70, 0x61, 0x72, 0x73, 0x65, 0x44,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x70, 0x61, 0x72, 0x73,
+					0x65, 0x73, 0x20, 0x61, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d,
+					0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x20, 0x61, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x64, 0x65,
+					0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x61, 0x0a, 0x2f, 0x2f,
+					0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x74,
+					0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x73, 0x65,
+					0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72,
+					0x77, 0x69, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d,
+					0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x75,
+					0x6e, 0x69, 0x74, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20,
+					0x75, 0x73, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x70, 0x61,
+					0x72, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x20, 0x28, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x69, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
+					0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x2e, 0x50, 0x61, 0x72, 0x73,
+					0x65, 0x49, 0x6e, 0x74, 0x28, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20,
+					0x31, 0x30, 0x2c, 0x20, 0x36, 0x34, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x69, 0x29, 0x20, 0x2a, 0x20, 0x74,
+					0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x29, 0x2c,
+					0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x64, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+					0x50, 0x61, 0x72, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x28, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x29, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x28, 0x64, 0x29, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x73, 0x65, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x20,
+					0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69,
+					0x6e, 0x74, 0x6f, 0x20, 0x76, 0x2c, 0x20, 0x69, 0x66, 0x20, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x76, 0x61,
+					0x6c, 0x69, 0x64, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2c, 0x20, 0x69, 0x74,
+					0x73, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x0a, 0x2f, 0x2f,
+					0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68,
+					0x61, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61,
+					0x74, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66, 0x72, 0x6f,
+					0x6d, 0x20, 0x61, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x64,
+					0x6f, 0x6e, 0x27, 0x74, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x74, 0x6f,
+					0x20, 0x62, 0x65, 0x20, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x73, 0x65, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x28,
+					0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e,
+					0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x21,
+					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x28, 0x5b,
+					0x5d, 0x62, 0x79, 0x74, 0x65, 0x28, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x29,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x3d, 0x20, 0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x2e, 0x51, 0x75,
+					0x6f, 0x74, 0x65, 0x28, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73,
+					0x68, 0x61, 0x6c, 0x28, 0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x28, 0x76,
+					0x61, 0x6c, 0x75, 0x65, 0x29, 0x2c, 0x20, 0x76, 0x29, 0x3b, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x76, 0x61, 0x6c,
+					0x75, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x66, 0x75, 0x6c, 0x6c,
+					0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x73,
+					0x65, 0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65, 0x63, 0x6f,
+					0x64, 0x65, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x6e,
+					0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65,
+					0x20, 0x76, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73,
+					0x20, 0x65, 0x69, 0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x20, 0x6a, 0x73,
+					0x6f, 0x6e, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x20, 0x6f, 0x72, 0x20,
+					0x61, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x0a, 0x2f, 0x2f, 0x20, 0x73,
+					0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x73,
+					0x74, 0x20, 0x6f, 0x66, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2c,
+					0x20, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x20, 0x69, 0x6e, 0x64, 0x69, 0x63,
+					0x61, 0x74, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x73, 0x68, 0x6f,
+					0x75, 0x6c, 0x64, 0x20, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x20, 0x62,
+					0x65, 0x20, 0x74, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x0a, 0x2f, 0x2f,
+					0x20, 0x61, 0x73, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x73, 0x65, 0x74, 0x53, 0x6c, 0x69, 0x63,
+					0x65, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x71, 0x75,
+					0x6f, 0x74, 0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x2c, 0x20, 0x76, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x20, 0x3d, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x73, 0x2e, 0x54, 0x72, 0x69, 0x6d, 0x53, 0x70, 0x61, 0x63, 0x65, 0x28,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x21,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x50,
+					0x72, 0x65, 0x66, 0x69, 0x78, 0x28, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c,
+					0x20, 0x22, 0x5b, 0x22, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x74,
+					0x65, 0x6d, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x7b, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x76,
+					0x61, 0x6c, 0x75, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x3d, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+					0x74, 0x28, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x22, 0x2c, 0x22,
+					0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20,
+					0x69, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x69,
+					0x74, 0x65, 0x6d, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x74,
+					0x65, 0x6d, 0x73, 0x5b, 0x69, 0x5d, 0x20, 0x3d, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x73, 0x2e, 0x54, 0x72, 0x69, 0x6d, 0x53, 0x70, 0x61,
+					0x63, 0x65, 0x28, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5b, 0x69, 0x5d, 0x29,
+					0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x71, 0x75, 0x6f, 0x74, 0x65,
+					0x20, 0x7c, 0x7c, 0x20, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x56, 0x61,
+					0x6c, 0x69, 0x64, 0x28, 0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x28, 0x69,
+					0x74, 0x65, 0x6d, 0x73, 0x5b, 0x69, 0x5d, 0x29, 0x29, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5b, 0x69, 0x5d,
+					0x20, 0x3d, 0x20, 0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x2e, 0x51,
+					0x75, 0x6f, 0x74, 0x65, 0x28, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5b, 0x69,
+					0x5d, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x3d, 0x20, 0x22, 0x5b,
+					0x22, 0x20, 0x2b, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e,
+					0x4a, 0x6f, 0x69, 0x6e, 0x28, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2c, 0x20,
+					0x22, 0x2c, 0x22, 0x29, 0x20, 0x2b, 0x20, 0x22, 0x5d, 0x22, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x73, 0x65,
+					0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x66, 0x75, 0x6c, 0x6c, 0x2c, 0x20,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x76, 0x29, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x55, 0x73, 0x65, 0x73, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x7d, 0x7d, 0x0a, 0x2f, 0x2f,
+					0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x63,
+					0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x73, 0x20, 0x32, 0x20, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x73, 0x20, 0x76, 0x69, 0x61, 0x20, 0x74, 0x68, 0x65,
+					0x69, 0x72, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x65, 0x6e, 0x63, 0x6f,
+					0x64, 0x69, 0x6e, 0x67, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x65, 0x71,
+					0x75, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x61, 0x2c, 0x20, 0x62,
+					0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d,
+					0x29, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x6a, 0x61,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x61, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73,
+					0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x61,
+					0x29, 0x0a, 0x09, 0x6a, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x62, 0x20,
+					0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73,
+					0x68, 0x61, 0x6c, 0x28, 0x62, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x61, 0x20, 0x3d, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x26, 0x26, 0x20, 0x65, 0x72, 0x72, 0x62, 0x20, 0x3d,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x26, 0x26, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x28, 0x6a, 0x61, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x6a, 0x62, 0x29, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x20, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+					0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62,
+					0x65, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x20, 0x77,
+					0x69, 0x74, 0x68, 0x20, 0x53, 0x77, 0x61, 0x70, 0x20, 0x77, 0x68, 0x69,
+					0x6c, 0x65, 0x20, 0x69, 0x74, 0x27, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x62,
+					0x65, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x72,
+					0x6f, 0x6d, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x67, 0x6f, 0x72,
+					0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+					0x62, 0x79, 0x20, 0x47, 0x65, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20,
+					0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x0a, 0x2f, 0x2f, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x27, 0x73, 0x20, 0x6e, 0x65, 0x76, 0x65, 0x72,
+					0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x62, 0x79,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x2c,
+					0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f,
+					0x74, 0x20, 0x62, 0x65, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+					0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c,
+					0x6c, 0x65, 0x72, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x48, 0x6f, 0x6c,
+					0x64, 0x65, 0x72, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b,
+					0x0a, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x74,
+					0x6f, 0x6d, 0x69, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x0a, 0x09,
+					0x2f, 0x2f, 0x20, 0x73, 0x77, 0x61, 0x70, 0x4c, 0x6f, 0x63, 0x6b, 0x20,
+					0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x73, 0x20, 0x53,
+					0x77, 0x61, 0x70, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+					0x62, 0x65, 0x72, 0x73, 0x20, 0x73, 0x65, 0x65, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20,
+					0x6f, 0x72, 0x64, 0x65, 0x72, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x4c,
+					0x6f, 0x63, 0x6b, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x4d, 0x75, 0x74,
+					0x65, 0x78, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x6c, 0x6f, 0x63, 0x6b, 0x20,
+					0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x0a,
+					0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x66, 0x75,
+					0x6e, 0x63, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5b,
+					0x5d, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63,
+					0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x09, 0x6e, 0x65, 0x78,
+					0x74, 0x49, 0x44, 0x20, 0x20, 0x20, 0x69, 0x6e, 0x74, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x74,
+					0x61, 0x74, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76,
+					0x61, 0x6c, 0x75, 0x65, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x20,
+					0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f, 0x6c, 0x64, 0x65,
+					0x72, 0x27, 0x73, 0x20, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x2e, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x68, 0x6f,
+					0x6c, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x20, 0x73, 0x74,
+					0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x20, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x20, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x68, 0x6f, 0x6c, 0x64, 0x65,
+					0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09,
+					0x69, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x0a, 0x09, 0x66, 0x6e, 0x20, 0x66,
+					0x75, 0x6e, 0x63, 0x28, 0x70, 0x72, 0x65, 0x76, 0x2c, 0x20, 0x6e, 0x65,
+					0x78, 0x74, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x4e, 0x65, 0x77, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x48, 0x6f, 0x6c,
+					0x64, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x63, 0x6f,
+					0x70, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
+					0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x69, 0x73,
+					0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2c, 0x0a, 0x2f,
+					0x2f, 0x20, 0x69, 0x66, 0x20, 0x73, 0x65, 0x74, 0x20, 0x69, 0x74, 0x73,
+					0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x63,
+					0x68, 0x65, 0x63, 0x6b, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x69,
+					0x74, 0x69, 0x61, 0x6c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x65, 0x61, 0x63,
+					0x68, 0x20, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x20, 0x69, 0x6e,
+					0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x4e, 0x65, 0x77, 0x48,
+					0x6f, 0x6c, 0x64, 0x65, 0x72, 0x28, 0x63, 0x20, 0x2a, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
+					0x20, 0x28, 0x2a, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+					0x74, 0x65, 0x28, 0x63, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x68,
+					0x20, 0x3a, 0x3d, 0x20, 0x26, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x7b,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x20, 0x76, 0x61,
+					0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x7d, 0x0a, 0x09, 0x68, 0x2e, 0x63,
+					0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+					0x28, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+					0x7b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x63, 0x2e, 0x43,
+					0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x76, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x31, 0x7d, 0x29, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x47, 0x65, 0x74, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75,
+					0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x28, 0x68, 0x20, 0x2a, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x29,
+					0x20, 0x47, 0x65, 0x74, 0x28, 0x29, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e, 0x63, 0x75,
+					0x72, 0x72, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x29,
+					0x2e, 0x28, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+					0x65, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x43,
 					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-					0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75,
-					0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-					0x61, 0x6d, 0x65, 0x2e, 0x0a, 0x2f, 0x2f, 0x20, 0x4f, 0x76, 0x65, 0x72,
-					0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
-					0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x69, 0x6c,
-					0x6c, 0x20, 0x62, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-					0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68,
-					0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x2f,
-					0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-					0x6d, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x73, 0x20, 0x64,
-					0x65, 0x72, 0x76, 0x69, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
-					0x5b, 0x69, 0x6e, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5d, 0x0a, 0x2f,
-					0x2f, 0x20, 0x20, 0x20, 0x20, 0x31, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74,
+					0x73, 0x20, 0x61, 0x74, 0x0a, 0x2f, 0x2f, 0x20, 0x31, 0x20, 0x61, 0x6e,
+					0x64, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+					0x6e, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x65, 0x61, 0x63, 0x68,
+					0x20, 0x53, 0x77, 0x61, 0x70, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28,
+					0x68, 0x20, 0x2a, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x29, 0x20, 0x56,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x28, 0x29, 0x20, 0x75, 0x69, 0x6e,
+					0x74, 0x36, 0x34, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x68, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x2e,
+					0x4c, 0x6f, 0x61, 0x64, 0x28, 0x29, 0x2e, 0x28, 0x68, 0x6f, 0x6c, 0x64,
+					0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x29, 0x2e, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x53,
+					0x77, 0x61, 0x70, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+					0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65,
+					0x6e, 0x74, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20,
+					0x63, 0x6f, 0x70, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x2c, 0x20, 0x61,
+					0x6e, 0x64, 0x0a, 0x2f, 0x2f, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+					0x75, 0x73, 0x20, 0x6f, 0x6e, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20,
+					0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x73,
+					0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x6c, 0x79,
+					0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x53, 0x77, 0x61, 0x70,
+					0x0a, 0x2f, 0x2f, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x2c,
+					0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x63, 0x61,
+					0x6e, 0x63, 0x65, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x73,
+					0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
+					0x20, 0x62, 0x75, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f,
+					0x74, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x20, 0x53, 0x77, 0x61, 0x70, 0x20,
+					0x74, 0x68, 0x65, 0x6d, 0x73, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2c, 0x0a,
+					0x2f, 0x2f, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72,
+					0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x53, 0x77,
+					0x61, 0x70, 0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20,
+					0x74, 0x68, 0x65, 0x6d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x68,
+					0x20, 0x2a, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x29, 0x20, 0x53, 0x77,
+					0x61, 0x70, 0x28, 0x63, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x28, 0x2a, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x69, 0x66, 0x20, 0x68, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+					0x65, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x68,
+					0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x28, 0x63, 0x29,
+					0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x68, 0x2e, 0x73, 0x77, 0x61, 0x70,
+					0x4c, 0x6f, 0x63, 0x6b, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a,
+					0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x68, 0x2e, 0x73, 0x77, 0x61,
+					0x70, 0x4c, 0x6f, 0x63, 0x6b, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+					0x28, 0x29, 0x0a, 0x09, 0x77, 0x61, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x68,
+					0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x61,
+					0x64, 0x28, 0x29, 0x2e, 0x28, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53,
+					0x74, 0x61, 0x74, 0x65, 0x29, 0x0a, 0x09, 0x6e, 0x6f, 0x77, 0x20, 0x3a,
+					0x3d, 0x20, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+					0x65, 0x7b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x63, 0x2e,
+					0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x77, 0x61, 0x73, 0x2e, 0x76, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x2b, 0x20, 0x31, 0x7d, 0x0a, 0x09,
+					0x68, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74,
+					0x6f, 0x72, 0x65, 0x28, 0x6e, 0x6f, 0x77, 0x29, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+					0x62, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x61, 0x6c,
+					0x6c, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20,
+					0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x6c, 0x6f, 0x63, 0x6b,
+					0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x74, 0x68,
+					0x65, 0x79, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65,
+					0x6c, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20,
+					0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x6f, 0x6e, 0x27, 0x74, 0x20,
+					0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+					0x69, 0x62, 0x65, 0x0a, 0x09, 0x68, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+					0x4c, 0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+					0x20, 0x3a, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x5b,
+					0x5d, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63,
+					0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x6e, 0x69, 0x6c, 0x29,
+					0x2c, 0x20, 0x68, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x2e, 0x2e, 0x2e, 0x29,
+					0x0a, 0x09, 0x68, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x55, 0x6e, 0x6c,
+					0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f,
+					0x2c, 0x20, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x73, 0x75, 0x62, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x73, 0x2e,
+					0x66, 0x6e, 0x28, 0x77, 0x61, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x2c, 0x20, 0x6e, 0x6f, 0x77, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x77, 0x61, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x4f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x72, 0x65, 0x67,
+					0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x20, 0x66, 0x6e, 0x20, 0x74, 0x6f,
+					0x20, 0x62, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x62,
+					0x79, 0x20, 0x53, 0x77, 0x61, 0x70, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
+					0x61, 0x6e, 0x79, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x6e,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x2f, 0x2f, 0x20, 0x63, 0x68,
+					0x61, 0x6e, 0x67, 0x65, 0x73, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6e, 0x63,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x61,
+					0x6e, 0x63, 0x65, 0x6c, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75,
+					0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x48, 0x6f, 0x6c, 0x64,
+					0x65, 0x72, 0x29, 0x20, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+					0x28, 0x66, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x70, 0x72, 0x65,
+					0x76, 0x2c, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x2a, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x29,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e, 0x73, 0x75, 0x62, 0x73,
+					0x63, 0x72, 0x69, 0x62, 0x65, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x70,
+					0x72, 0x65, 0x76, 0x2c, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x2a, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x21, 0x70, 0x72,
+					0x65, 0x76, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x6e, 0x65, 0x78,
+					0x74, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6e, 0x28, 0x70,
+					0x72, 0x65, 0x76, 0x2c, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x29, 0x0a, 0x09,
+					0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x48, 0x6f, 0x6c, 0x64, 0x65,
+					0x72, 0x29, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+					0x28, 0x66, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x70, 0x72, 0x65,
+					0x76, 0x2c, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x2a, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x29,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x68,
+					0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x28, 0x29,
+					0x0a, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x68, 0x2e, 0x6c, 0x6f,
+					0x63, 0x6b, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a,
+					0x09, 0x68, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x44, 0x2b, 0x2b, 0x0a,
+					0x09, 0x69, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x2e, 0x6e, 0x65, 0x78,
+					0x74, 0x49, 0x44, 0x0a, 0x09, 0x68, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x20,
+					0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x68, 0x2e, 0x73,
+					0x75, 0x62, 0x73, 0x2c, 0x20, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53,
+					0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x7b,
+					0x69, 0x64, 0x3a, 0x20, 0x69, 0x64, 0x2c, 0x20, 0x66, 0x6e, 0x3a, 0x20,
+					0x66, 0x6e, 0x7d, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x68, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x28,
+					0x29, 0x0a, 0x09, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x68, 0x2e,
+					0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x28,
+					0x29, 0x0a, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x64, 0x78, 0x20,
+					0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x68, 0x2e, 0x73,
+					0x75, 0x62, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20,
+					0x68, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x5b, 0x69, 0x64, 0x78, 0x5d, 0x2e,
+					0x69, 0x64, 0x20, 0x3d, 0x3d, 0x20, 0x69, 0x64, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x09, 0x68, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x20, 0x3d, 0x20,
+					0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x68, 0x2e, 0x73, 0x75, 0x62,
+					0x73, 0x5b, 0x3a, 0x69, 0x64, 0x78, 0x5d, 0x2c, 0x20, 0x68, 0x2e, 0x73,
+					0x75, 0x62, 0x73, 0x5b, 0x69, 0x64, 0x78, 0x2b, 0x31, 0x3a, 0x5d, 0x2e,
+					0x2e, 0x2e, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x28, 0x69, 0x6e, 0x64,
+					0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20,
+					0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x22, 0x29, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20,
+					0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x73,
+					0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x2f, 0x2f, 0x20, 0x4c,
+					0x6f, 0x61, 0x64, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x61, 0x74, 0x74,
+					0x65, 0x6d, 0x70, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+					0x20, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2e, 0x0a, 0x2f,
+					0x2f, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20,
+					0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x61,
+					0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x62, 0x61, 0x73, 0x65, 0x64,
+					0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x75, 0x73, 0x65,
+					0x64, 0x20, 0x69, 0x73, 0x20, 0x64, 0x65, 0x72, 0x76, 0x69, 0x65, 0x64,
+					0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x5b, 0x69, 0x6e, 0x20, 0x6f, 0x72,
+					0x64, 0x65, 0x72, 0x5d, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x31,
+					0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x70,
+					0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x20, 0x69, 0x66, 0x20,
+					0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20,
+					0x20, 0x32, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x45, 0x6e, 0x76,
+					0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x76, 0x61, 0x72,
+					0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x65, 0x6e, 0x76,
+					0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x69, 0x66, 0x20,
+					0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20,
+					0x20, 0x33, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x53, 0x20, 0x73,
+					0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c,
+					0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65,
+					0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+					0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+					0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x28,
+					0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20,
 					0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72,
-					0x72, 0x69, 0x64, 0x65, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-					0x65, 0x72, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22,
-					0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x32, 0x29, 0x20, 0x74, 0x68,
-					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
-					0x68, 0x65, 0x20, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
-					0x6e, 0x74, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20,
-					0x69, 0x6e, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d,
-					0x65, 0x2c, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22,
-					0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x33, 0x29, 0x20, 0x74, 0x68,
-					0x65, 0x20, 0x4f, 0x53, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
-					0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x66,
-					0x75, 0x6e, 0x63, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
-					0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c,
-					0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65,
-					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-					0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c,
+					0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+					0x61, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a, 0x2f, 0x2f, 0x20,
+					0x74, 0x79, 0x70, 0x69, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x20, 0x79, 0x6f,
+					0x75, 0x27, 0x64, 0x20, 0x6a, 0x75, 0x73, 0x74, 0x20, 0x75, 0x73, 0x65,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x74,
+					0x68, 0x69, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x75,
+					0x73, 0x65, 0x66, 0x75, 0x6c, 0x20, 0x69, 0x66, 0x20, 0x79, 0x6f, 0x75,
+					0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x0a, 0x2f, 0x2f, 0x20,
+					0x64, 0x6f, 0x20, 0x6d, 0x6f, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x72,
+					0x69, 0x63, 0x61, 0x74, 0x65, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20,
+					0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x4c,
+					0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+					0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28,
+					0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
+					0x7b, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x6f, 0x72, 0x20,
+					0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+					0x6f, 0x6e, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+					0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x69, 0x6c, 0x65,
+					0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x77, 0x72, 0x61, 0x70, 0x4c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x28, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61,
+					0x64, 0x65, 0x72, 0x2c, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x29, 0x29,
+					0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
+					0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x29,
+					0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+					0x72, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75,
+					0x6e, 0x63, 0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x28,
+					0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x6c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x72,
+					0x65, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76,
+					0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d,
+					0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x66, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x72,
+					0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65,
+					0x28, 0x72, 0x65, 0x66, 0x2c, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+					0x6d, 0x65, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x66, 0x6e, 0x2c, 0x20,
+					0x76, 0x29, 0x0a, 0x09, 0x7d, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x64, 0x65,
+					0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x0a, 0x2f, 0x2f, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x20, 0x69, 0x74, 0x20, 0x72, 0x65, 0x66, 0x65,
+					0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72,
+					0x6f, 0x6d, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x2c, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20, 0x6c, 0x6f,
+					0x61, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x4c,
+					0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x29,
+					0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
 					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61,
-					0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
-					0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x28, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x0a,
+					0x09, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x28, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66,
+					0x20, 0x2e, 0x48, 0x61, 0x73, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7d, 0x7d, 0x0a, 0x09, 0x76, 0x61, 0x72,
+					0x20, 0x72, 0x61, 0x77, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x28, 0x6e, 0x61, 0x6d, 0x65,
+					0x2c, 0x20, 0x26, 0x72, 0x61, 0x77, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x2e, 0x61, 0x64, 0x64, 0x57, 0x61, 0x72, 0x6e, 0x69,
+					0x6e, 0x67, 0x73, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x72, 0x61,
+					0x77, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x09, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x28, 0x6c, 0x6f,
+					0x61, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09,
+					0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61,
+					0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x29,
+					0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a,
+					0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x73, 0x20, 0x68, 0x6f,
+					0x77, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x2a,
+					0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
+					0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63,
+					0x74, 0x20, 0x7b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x20, 0x20, 0x20, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75,
+					0x6e, 0x63, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x28, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x29, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09,
+					0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64,
+					0x65, 0x72, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x4c, 0x6f, 0x61,
+					0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x0a, 0x09, 0x73,
+					0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x62, 0x6f, 0x6f, 0x6c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+					0x74, 0x6f, 0x72, 0x73, 0x20, 0x20, 0x20, 0x5b, 0x5d, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a,
+					0x09, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x5b, 0x5d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x75, 0x73, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x6c, 0x65,
+					0x63, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x28, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6c, 0x6f, 0x61, 0x64,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x6f, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x3d, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x09,
+					0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x57, 0x69, 0x74, 0x68,
+					0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63,
+					0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x2c, 0x20, 0x69, 0x74, 0x27, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64,
+					0x0a, 0x2f, 0x2f, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x20,
+					0x6f, 0x66, 0x20, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x6e,
+					0x27, 0x74, 0x20, 0x73, 0x65, 0x74, 0x20, 0x62, 0x79, 0x20, 0x57, 0x69,
+					0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f,
+					0x72, 0x20, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e, 0x76, 0x4b, 0x65, 0x79,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f,
+					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x28, 0x66,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x29,
+					0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f,
+					0x20, 0x2a, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x68, 0x6f, 0x73,
+					0x74, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x3d, 0x20,
+					0x66, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x57,
+					0x69, 0x74, 0x68, 0x45, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x20, 0x73, 0x70,
+					0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x20,
+					0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x74, 0x68, 0x61,
+					0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e, 0x76,
+					0x4b, 0x65, 0x79, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61,
+					0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a,
+					0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x65, 0x6e, 0x76, 0x4b, 0x65,
+					0x79, 0x20, 0x3d, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61,
+					0x6d, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x73,
+					0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74,
+					0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+					0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65,
+					0x6c, 0x73, 0x28, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x6d, 0x61,
+					0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75,
+					0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f,
+					0x2e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x3d, 0x20, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x61, 0x6e,
+					0x64, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20,
+					0x4c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63,
+					0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28,
+					0x6f, 0x20, 0x2a, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x6c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+					0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x57,
+					0x69, 0x74, 0x68, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x63, 0x61,
+					0x75, 0x73, 0x65, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20,
+					0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74,
+					0x20, 0x64, 0x6f, 0x6e, 0x27, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74,
+					0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f,
+					0x20, 0x62, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72,
+					0x74, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x73, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27,
+					0x74, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x20, 0x69, 0x66, 0x20, 0x61,
+					0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x20, 0x77, 0x69,
+					0x74, 0x68, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x4c,
+					0x6f, 0x61, 0x64, 0x65, 0x72, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x57,
+					0x69, 0x74, 0x68, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x28, 0x29, 0x20,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20,
+					0x2a, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x69,
+					0x63, 0x74, 0x20, 0x3d, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x09, 0x7d,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x57, 0x69, 0x74, 0x68, 0x56,
+					0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x64,
+					0x64, 0x73, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x27, 0x73, 0x20, 0x63, 0x61, 0x6c,
+					0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x69,
+					0x6e, 0x67, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x69, 0x66, 0x20,
+					0x69, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61,
+					0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+					0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x57, 0x69, 0x74,
+					0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
+					0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f,
+					0x20, 0x2a, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x76, 0x61, 0x6c,
+					0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70,
+					0x70, 0x65, 0x6e, 0x64, 0x28, 0x6f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+					0x61, 0x74, 0x6f, 0x72, 0x73, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64,
+					0x61, 0x74, 0x65, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x73, 0x20, 0x61, 0x64, 0x64, 0x73, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+					0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x70,
+					0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x6f, 0x72, 0x64,
+					0x65, 0x72, 0x2c, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x0a,
+					0x2f, 0x2f, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c,
+					0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+					0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+					0x64, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73,
+					0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73,
+					0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65,
+					0x74, 0x73, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20,
+					0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x74, 0x68, 0x61,
+					0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x0a, 0x7b, 0x7b,
+					0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x28, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x20, 0x2e, 0x2e,
+					0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e,
+					0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70,
+					0x65, 0x6e, 0x64, 0x28, 0x6f, 0x2e, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+					0x2c, 0x20, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x2e, 0x2e, 0x2e, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d,
+					0x70, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x0a, 0x2f, 0x2f, 0x20,
+					0x61, 0x6e, 0x64, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20,
+					0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x26, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x73, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65,
+					0x78, 0x74, 0x72, 0x61, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x20, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x2e, 0x0a, 0x2f, 0x2f,
+					0x20, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x4c, 0x6f, 0x61, 0x64,
+					0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e,
+					0x27, 0x74, 0x20, 0x75, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67,
+					0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20,
+					0x73, 0x65, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x57, 0x69, 0x74,
+					0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69,
+					0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x69,
+					0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x73, 0x20, 0x2e, 0x2e, 0x2e, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x6f, 0x20,
+					0x3a, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x7b, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c,
+					0x20, 0x6f, 0x70, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x6f, 0x70, 0x74, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f,
+					0x70, 0x74, 0x28, 0x26, 0x6f, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x6c,
+					0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x2e, 0x6c,
+					0x6f, 0x61, 0x64, 0x65, 0x72, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x6c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x3d,
+					0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x0a, 0x09, 0x09,
+					0x69, 0x66, 0x20, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20,
+					0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x74,
+					0x72, 0x69, 0x63, 0x74, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x6f, 0x72, 0x20, 0x2e, 0x53,
+					0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x20, 0x2e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+					0x7d, 0x7d, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+					0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20,
+					0x69, 0x73, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x62,
+					0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+					0x64, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x57, 0x69,
+					0x74, 0x68, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x64, 0x6f, 0x65,
+					0x73, 0x6e, 0x27, 0x74, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x20, 0x74,
+					0x6f, 0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x6c,
+					0x6f, 0x61, 0x64, 0x65, 0x72, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+					0x72, 0x20, 0x3d, 0x20, 0x77, 0x72, 0x61, 0x70, 0x4c, 0x6f, 0x61, 0x64,
+					0x65, 0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x6f,
+					0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x26, 0x26, 0x20, 0x6f,
+					0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20,
 					0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
 					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69,
-					0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x73, 0x2e, 0x46, 0x6f, 0x72, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79,
-					0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-					0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a,
-					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x6f,
+					0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3d,
+					0x3d, 0x20, 0x22, 0x22, 0x20, 0x26, 0x26, 0x20, 0x6f, 0x2e, 0x68, 0x6f,
+					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x26, 0x26, 0x20, 0x28, 0x6f, 0x2e,
+					0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x22,
+					0x20, 0x7c, 0x7c, 0x20, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x65, 0x6e,
+					0x76, 0x28, 0x6f, 0x2e, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x29, 0x20,
+					0x3d, 0x3d, 0x20, 0x22, 0x22, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6f, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x3b, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e,
+					0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x63, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x46, 0x6f,
+					0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x6f, 0x2e, 0x65, 0x6e,
+					0x76, 0x4b, 0x65, 0x79, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x2c, 0x20, 0x6f, 0x2e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+					0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x64, 0x78,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x2e,
+					0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x2d,
+					0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x46, 0x69, 0x78, 0x65,
+					0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x66, 0x69, 0x78,
+					0x65, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x2e, 0x6c, 0x61, 0x79, 0x65,
+					0x72, 0x73, 0x5b, 0x69, 0x64, 0x78, 0x5d, 0x2e, 0x66, 0x69, 0x78, 0x65,
+					0x64, 0x53, 0x65, 0x74, 0x28, 0x22, 0x22, 0x29, 0x3b, 0x20, 0x6c, 0x65,
+					0x6e, 0x28, 0x66, 0x69, 0x78, 0x65, 0x64, 0x29, 0x20, 0x3e, 0x20, 0x30,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x20, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x20, 0x25, 0x64, 0x20,
+					0x73, 0x65, 0x74, 0x73, 0x20, 0x25, 0x73, 0x2c, 0x20, 0x77, 0x68, 0x69,
+					0x63, 0x68, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x2c,
+					0x20, 0x69, 0x64, 0x78, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x28, 0x66, 0x69, 0x78, 0x65, 0x64,
+					0x2c, 0x20, 0x22, 0x2c, 0x20, 0x22, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09,
+					0x09, 0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46,
+					0x72, 0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x2e, 0x6c, 0x61, 0x79, 0x65, 0x72,
+					0x73, 0x5b, 0x69, 0x64, 0x78, 0x5d, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
+					0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64,
+					0x61, 0x74, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x6f, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+					0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3d, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x28,
+					0x63, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x63, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x53,
 					0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65,
 					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65,
-					0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72,
-					0x6f, 0x72, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x79, 0x70, 0x69, 0x63, 0x61,
-					0x6c, 0x6c, 0x79, 0x20, 0x79, 0x6f, 0x75, 0x27, 0x64, 0x20, 0x6a, 0x75,
-					0x73, 0x74, 0x20, 0x75, 0x73, 0x65, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x2c,
-					0x20, 0x62, 0x75, 0x74, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63, 0x61,
-					0x6e, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x20,
-					0x69, 0x66, 0x20, 0x79, 0x6f, 0x75, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20,
-					0x74, 0x6f, 0x0a, 0x2f, 0x2f, 0x20, 0x64, 0x6f, 0x20, 0x6d, 0x6f, 0x72,
-					0x65, 0x20, 0x69, 0x6e, 0x74, 0x72, 0x69, 0x63, 0x61, 0x74, 0x65, 0x20,
-					0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-					0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x72,
-					0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x0a,
-					0x66, 0x75, 0x6e, 0x63, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28,
-					0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72,
-					0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65,
-					0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x28,
-					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x29, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
-					0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x66,
-					0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x73, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72,
-					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
-					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c,
-					0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20,
-					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72,
-					0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
-					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-					0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3d,
-					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
-					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x7b, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x6c, 0x6f, 0x61,
-					0x64, 0x65, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x3a, 0x3d, 0x20,
-					0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x62,
-					0x6f, 0x6f, 0x6c, 0x7b, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f,
-					0x2c, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x3a,
-					0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66,
-					0x69, 0x67, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
-					0x2e, 0x48, 0x61, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x28, 0x6f,
-					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2c, 0x20, 0x22, 0x66, 0x69,
-					0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x22, 0x29, 0x20, 0x26, 0x26, 0x20, 0x21,
-					0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5b,
-					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5d, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x6d, 0x61, 0x72, 0x6b, 0x20, 0x61,
-					0x73, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x0a, 0x09, 0x09, 0x09,
-					0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5b,
-					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5d, 0x20, 0x3d, 0x20,
-					0x74, 0x72, 0x75, 0x65, 0x0a, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6e, 0x2c,
-					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x65, 0x73, 0x6f,
-					0x6c, 0x76, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43,
-					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x6f, 0x76,
-					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5b, 0x37, 0x3a, 0x5d, 0x2c, 0x20,
-					0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x09,
-					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
-					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74,
-					0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72,
-					0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x28,
-					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20,
-					0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x66,
-					0x6e, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x29, 0x0a, 0x09,
-					0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
-					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65,
-					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72,
-					0x72, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x09, 0x09, 0x63,
-					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
-					0x69, 0x64, 0x65, 0x73, 0x5b, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-					0x65, 0x5d, 0x20, 0x3d, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x72, 0x65,
-					0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
-					0x63, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x66,
-					0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x73, 0x79, 0x73, 0x74,
+					0x65, 0x6d, 0x20, 0x66, 0x73, 0x79, 0x73, 0x2c, 0x20, 0x65, 0x2e, 0x67,
+					0x2e, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x2e, 0x46,
+					0x53, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f,
+					0x2f, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x73,
+					0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+					0x76, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x69,
+					0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x53,
+					0x28, 0x66, 0x73, 0x79, 0x73, 0x20, 0x66, 0x73, 0x2e, 0x46, 0x53, 0x2c,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x20, 0x6c, 0x6f,
+					0x61, 0x64, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46,
+					0x75, 0x6e, 0x63, 0x20, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x66,
+					0x6e, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53,
+					0x4f, 0x4e, 0x46, 0x53, 0x28, 0x66, 0x73, 0x79, 0x73, 0x2c, 0x20, 0x66,
+					0x6e, 0x2c, 0x20, 0x76, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d,
+					0x20, 0x69, 0x66, 0x20, 0x6f, 0x72, 0x20, 0x2e, 0x53, 0x63, 0x68, 0x65,
+					0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x2e, 0x48,
+					0x61, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d, 0x0a,
+					0x09, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x3d, 0x20, 0x77, 0x72, 0x61, 0x70,
+					0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x2c,
+					0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x6e,
+					0x61, 0x6d, 0x65, 0x2c, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20, 0x66,
+					0x75, 0x6e, 0x63, 0x28, 0x72, 0x65, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69,
 					0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
 					0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-					0x20, 0x7b, 0x0a, 0x09, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
-					0x3d, 0x20, 0x6f, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x28, 0x66, 0x69,
-					0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x28, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x53, 0x28, 0x66, 0x73, 0x79,
+					0x73, 0x2c, 0x20, 0x72, 0x65, 0x66, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65,
+					0x29, 0x2c, 0x20, 0x76, 0x29, 0x0a, 0x09, 0x7d, 0x29, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+					0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x72, 0x2c, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73,
+					0x20, 0x61, 0x72, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20,
+					0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x53, 0x20,
+					0x66, 0x69, 0x6c, 0x65, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2c,
+					0x20, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x74, 0x6f,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+					0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+					0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x28, 0x72, 0x20, 0x69, 0x6f, 0x2e,
+					0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6f,
+					0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x28, 0x72, 0x29, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x28,
+					0x62, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x6f, 0x61,
+					0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x20, 0x64,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+					0x62, 0x2c, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6c, 0x6f, 0x61,
+					0x64, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x4f, 0x53, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x73, 0x79, 0x73,
+					0x74, 0x65, 0x6d, 0x2c, 0x20, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+					0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72,
+					0x72, 0x65, 0x6e, 0x74, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+					0x72, 0x79, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x4c, 0x6f, 0x61, 0x64,
+					0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x62, 0x20,
+					0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x76, 0x61, 0x72, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x4c, 0x6f, 0x61,
+					0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x3d, 0x20,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x5f, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x72, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77,
+					0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x28, 0x62, 0x29, 0x29, 0x2e, 0x44,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x0a, 0x09, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x6f, 0x72, 0x20, 0x2e, 0x53,
+					0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x20, 0x2e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+					0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6c,
+					0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x22, 0x22, 0x2c, 0x20,
+					0x77, 0x72, 0x61, 0x70, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x6c,
+					0x6f, 0x61, 0x64, 0x2c, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x29, 0x2c,
+					0x20, 0x77, 0x72, 0x61, 0x70, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28,
+					0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2c, 0x20,
+					0x66, 0x61, 0x6c, 0x73, 0x65, 0x29, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20,
+					0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x28,
+					0x22, 0x22, 0x2c, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20, 0x4a, 0x53,
+					0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x29, 0x0a, 0x7b, 0x7b,
+					0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b,
+					0x2d, 0x20, 0x69, 0x66, 0x20, 0x6f, 0x72, 0x20, 0x2e, 0x53, 0x63, 0x68,
+					0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x2e,
+					0x48, 0x61, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d,
+					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x77, 0x72, 0x61, 0x70, 0x4c, 0x6f, 0x61,
+					0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+					0x61, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61,
+					0x74, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73,
+					0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20,
+					0x61, 0x6e, 0x64, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x69, 0x72, 0x0a, 0x2f, 0x2f, 0x20, 0x6a, 0x73, 0x6f,
+					0x6e, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x69, 0x74, 0x27,
+					0x73, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x69, 0x6e,
+					0x74, 0x6f, 0x20, 0x76, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+					0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65,
+					0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x69, 0x66, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x77, 0x72, 0x61, 0x70, 0x4c, 0x6f,
+					0x61, 0x64, 0x65, 0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x2c, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x29,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e,
+					0x63, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x61,
+					0x6e, 0x64, 0x20, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x52, 0x65,
+					0x6e, 0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x2f, 0x2f, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20,
+					0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x69,
+					0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x77, 0x68,
+					0x69, 0x63, 0x68, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x6d, 0x6f, 0x76, 0x65,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x6e, 0x61,
+					0x6d, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x6d,
+					0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x61, 0x64,
+					0x65, 0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20, 0x66, 0x61, 0x6c,
+					0x73, 0x65, 0x29, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x29,
+					0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66,
+					0x20, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c,
+					0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20,
+					0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4c,
+					0x6f, 0x61, 0x64, 0x65, 0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x20,
+					0x69, 0x6e, 0x20, 0x6d, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x61,
+					0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20, 0x6b,
+					0x65, 0x79, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63,
+					0x68, 0x65, 0x64, 0x20, 0x63, 0x61, 0x73, 0x65, 0x0a, 0x2f, 0x2f, 0x20,
+					0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x6c,
+					0x79, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20,
+					0x61, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x79,
+					0x27, 0x72, 0x65, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20,
+					0x69, 0x6e, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63,
+					0x74, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4b,
+					0x65, 0x79, 0x28, 0x6d, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x6d,
+					0x5b, 0x6b, 0x65, 0x79, 0x5d, 0x3b, 0x20, 0x6f, 0x6b, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6b, 0x65, 0x79,
+					0x2c, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66,
+					0x6f, 0x72, 0x20, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x6d, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x46, 0x6f, 0x6c, 0x64, 0x28, 0x6b, 0x2c, 0x20, 0x6b, 0x65, 0x79, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x6b, 0x2c, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x22, 0x22, 0x2c, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b,
+					0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x6e,
+					0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x72,
+					0x65, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x61, 0x64, 0x65,
+					0x72, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x69,
+					0x74, 0x68, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x2c, 0x20, 0x72, 0x65, 0x70,
+					0x6c, 0x61, 0x63, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x6c,
+					0x69, 0x61, 0x73, 0x65, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x6f, 0x66, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20,
+					0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+					0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+					0x74, 0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+					0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x76,
+					0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x66, 0x69,
+					0x65, 0x6c, 0x64, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x61, 0x72, 0x65, 0x20,
+					0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x69, 0x66, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x63, 0x74, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e,
+					0x20, 0x54, 0x68, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+					0x20, 0x61, 0x72, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x72, 0x65,
+					0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x76,
+					0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x73,
+					0x6f, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77,
+					0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20,
+					0x74, 0x68, 0x65, 0x6d, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20,
+					0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x72,
+					0x65, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x61, 0x64, 0x65,
+					0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a,
+					0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x2c, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x63, 0x74, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x29, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x7b,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x76, 0x61, 0x72, 0x20,
+					0x72, 0x61, 0x77, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x77,
+					0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x26,
+					0x72, 0x61, 0x77, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75,
+					0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6b, 0x65,
+					0x70, 0x74, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x61,
+					0x72, 0x65, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20,
+					0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x20, 0x77, 0x68,
+					0x65, 0x6e, 0x20, 0x69, 0x74, 0x27, 0x73, 0x20, 0x65, 0x6e, 0x63, 0x6f,
+					0x64, 0x65, 0x64, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x0a, 0x09, 0x09,
+					0x76, 0x61, 0x72, 0x20, 0x64, 0x6f, 0x63, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x09, 0x09, 0x64, 0x20,
+					0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73,
+					0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x28, 0x72,
+					0x61, 0x77, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x64, 0x2e, 0x55, 0x73, 0x65,
+					0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x64, 0x2e, 0x44,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x26, 0x64, 0x6f, 0x63, 0x29, 0x3b,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x76, 0x61,
+					0x72, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x62, 0x6f,
+					0x6f, 0x6c, 0x0a, 0x09, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20,
+					0x76, 0x2e, 0x28, 0x74, 0x79, 0x70, 0x65, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0a, 0x09,
+					0x09, 0x09, 0x6d, 0x2c, 0x20, 0x5f, 0x20, 0x3a, 0x3d, 0x20, 0x64, 0x6f,
+					0x63, 0x2e, 0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x6b, 0x2c,
+					0x20, 0x66, 0x76, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x6d, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x73, 0x77, 0x69,
+					0x74, 0x63, 0x68, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x63, 0x61,
+					0x73, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x45,
+					0x71, 0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x28, 0x6b, 0x2c, 0x20,
+					0x22, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x29, 0x3a,
+					0x0a, 0x09, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+					0x64, 0x20, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x28, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x2e, 0x72,
+					0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+					0x28, 0x66, 0x76, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x72, 0x65, 0x6e, 0x61,
+					0x6d, 0x65, 0x64, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x63, 0x61, 0x73, 0x65,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x28, 0x6b, 0x2c, 0x20, 0x22, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x29, 0x3a, 0x0a,
+					0x09, 0x09, 0x09, 0x09, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x2c, 0x20, 0x5f, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x76, 0x2e,
+					0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x0a, 0x09, 0x09, 0x09, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c,
+					0x20, 0x6f, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+					0x64, 0x20, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x28, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x2e, 0x72,
+					0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+					0x28, 0x6f, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d,
+					0x65, 0x64, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x63,
+					0x61, 0x73, 0x65, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x0a, 0x09, 0x09, 0x09, 0x72,
+					0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x3d, 0x20, 0x6e, 0x65, 0x77,
+					0x28, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x29, 0x2e, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6c,
+					0x69, 0x61, 0x73, 0x65, 0x73, 0x28, 0x64, 0x6f, 0x63, 0x29, 0x0a, 0x09,
+					0x09, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x72, 0x65, 0x6e, 0x61,
+					0x6d, 0x65, 0x64, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x62, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x64, 0x6f, 0x63, 0x29,
+					0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x61, 0x77, 0x20, 0x3d,
+					0x20, 0x62, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x64, 0x20, 0x3d,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x4e,
+					0x65, 0x77, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x28, 0x72, 0x61, 0x77,
+					0x29, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x64, 0x2e, 0x44, 0x69,
+					0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+					0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x28, 0x29, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x64,
+					0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x72, 0x65, 0x6e, 0x61,
+					0x6d, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61,
+					0x63, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61,
+					0x73, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66,
+					0x69, 0x65, 0x6c, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x20, 0x77,
+					0x69, 0x74, 0x68, 0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x0a, 0x2f, 0x2f, 0x20,
+					0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20,
+					0x69, 0x66, 0x20, 0x62, 0x6f, 0x74, 0x68, 0x20, 0x61, 0x72, 0x65, 0x20,
+					0x73, 0x65, 0x74, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x73, 0x20, 0x74, 0x72, 0x75, 0x65, 0x20, 0x69, 0x66, 0x20,
+					0x61, 0x6e, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61,
+					0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20,
+					0x73, 0x65, 0x74, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x72, 0x65, 0x6e,
+					0x61, 0x6d, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x6d, 0x20, 0x6d, 0x61,
+					0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x6b, 0x65,
+					0x79, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x61, 0x6c,
+					0x69, 0x61, 0x73, 0x65, 0x73, 0x20, 0x2e, 0x2e, 0x2e, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x29, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x5f, 0x2c, 0x20, 0x73, 0x65, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x28, 0x6d, 0x2c, 0x20, 0x6b, 0x65,
+					0x79, 0x29, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x20, 0x72, 0x65, 0x6e, 0x61,
+					0x6d, 0x65, 0x64, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x0a, 0x09, 0x66, 0x6f,
+					0x72, 0x20, 0x5f, 0x2c, 0x20, 0x61, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x6b, 0x2c, 0x20, 0x76,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6d, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x73, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c,
+					0x64, 0x28, 0x6b, 0x2c, 0x20, 0x61, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x09, 0x69, 0x66, 0x20, 0x21, 0x73, 0x65, 0x74, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x09, 0x09, 0x6d, 0x5b, 0x6b, 0x65, 0x79, 0x5d, 0x20,
+					0x3d, 0x20, 0x76, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
+					0x09, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x28, 0x6d, 0x2c, 0x20,
+					0x6b, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x6e, 0x61, 0x6d,
+					0x65, 0x64, 0x20, 0x3d, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x09, 0x09,
+					0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+					0x64, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x53, 0x63,
+					0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d,
+					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+					0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
+					0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+					0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2c, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6e, 0x20,
+					0x6f, 0x6c, 0x64, 0x65, 0x72, 0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x20, 0x5b, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+					0x69, 0x72, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6b,
+					0x65, 0x79, 0x2c, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x64, 0x65,
+					0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x5d,
+					0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+					0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x79, 0x27,
+					0x72, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x0a, 0x63, 0x6f,
+					0x6e, 0x73, 0x74, 0x20, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x20, 0x7b, 0x7b, 0x2e, 0x53,
+					0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x7d, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x63, 0x68,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73,
+					0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+					0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+					0x74, 0x68, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20,
+					0x6b, 0x65, 0x79, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x73, 0x20,
+					0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x69, 0x67, 0x72,
+					0x61, 0x74, 0x65, 0x64, 0x20, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+					0x20, 0x62, 0x79, 0x20, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x0a,
+					0x74, 0x79, 0x70, 0x65, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a,
+					0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x74,
+					0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x6f, 0x70, 0x20, 0x69, 0x73, 0x20, 0x6f,
+					0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x6d, 0x6f, 0x76, 0x65, 0x2c, 0x20,
+					0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x72,
+					0x65, 0x6d, 0x6f, 0x76, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x72, 0x65, 0x6e,
+					0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x6d, 0x6f, 0x76,
+					0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+					0x0a, 0x09, 0x6f, 0x70, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x2c,
+					0x20, 0x74, 0x6f, 0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x20,
+					0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+					0x72, 0x20, 0x6f, 0x66, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x20, 0x61, 0x74,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6f,
+					0x66, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74,
+					0x6f, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x2c, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69,
+					0x73, 0x20, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x69, 0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x20, 0x61,
+					0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+					0x20, 0x70, 0x61, 0x74, 0x68, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65,
+					0x64, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x6e, 0x74, 0x0a, 0x09, 0x63,
+					0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6d,
+					0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72,
+					0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x69, 0x6e,
+					0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65,
+					0x20, 0x61, 0x6e, 0x20, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x20, 0x76, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x6d, 0x69,
+					0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x20, 0x5b,
+					0x5d, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x0a,
+					0x7b, 0x7b, 0x2d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x4d,
+					0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+					0x69, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x2e, 0x7d, 0x7d,
+					0x2c, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
+					0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6c,
+					0x6f, 0x61, 0x64, 0x2c, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x6d, 0x69, 0x67, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x69,
+					0x74, 0x73, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x20,
+					0x61, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x69,
+					0x6e, 0x74, 0x6f, 0x20, 0x76, 0x2c, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
+					0x77, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x72,
+					0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x0a, 0x2f,
+					0x2f, 0x20, 0x61, 0x73, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20,
+					0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x69, 0x73,
+					0x20, 0x73, 0x65, 0x74, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x69,
+					0x67, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x61, 0x64, 0x65,
+					0x72, 0x28, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x4a,
+					0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x2c, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x63, 0x74, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x29, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x7b,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x76, 0x61, 0x72, 0x20,
+					0x72, 0x61, 0x77, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x77,
+					0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x26,
+					0x72, 0x61, 0x77, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75,
+					0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6b, 0x65,
+					0x70, 0x74, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x61,
+					0x72, 0x65, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20,
+					0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x20, 0x77, 0x68,
+					0x65, 0x6e, 0x20, 0x69, 0x74, 0x27, 0x73, 0x20, 0x65, 0x6e, 0x63, 0x6f,
+					0x64, 0x65, 0x64, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x0a, 0x09, 0x09,
+					0x76, 0x61, 0x72, 0x20, 0x64, 0x6f, 0x63, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x09, 0x09, 0x64, 0x20,
+					0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73,
+					0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x28, 0x72,
+					0x61, 0x77, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x64, 0x2e, 0x55, 0x73, 0x65,
+					0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x64, 0x2e, 0x44,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x26, 0x64, 0x6f, 0x63, 0x29, 0x3b,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x6d, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x64, 0x6f,
+					0x63, 0x2e, 0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x29, 0x3b, 0x20, 0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
+					0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+					0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x6d, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09,
+					0x09, 0x09, 0x69, 0x66, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+					0x64, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x72,
+					0x61, 0x77, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6a, 0x73,
+					0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x64,
+					0x6f, 0x63, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x64, 0x20, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4e, 0x65, 0x77, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x62,
+					0x79, 0x74, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x64,
+					0x65, 0x72, 0x28, 0x72, 0x61, 0x77, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x64, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+					0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x64, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x28, 0x76, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x53, 0x4f,
+					0x4e, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20,
+					0x74, 0x6f, 0x20, 0x64, 0x6f, 0x63, 0x2c, 0x20, 0x69, 0x74, 0x73, 0x0a,
+					0x2f, 0x2f, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20,
+					0x61, 0x73, 0x20, 0x61, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x69, 0x66, 0x20, 0x69,
+					0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x61, 0x20, 0x44, 0x65, 0x66, 0x61,
+					0x75, 0x6c, 0x74, 0x73, 0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20, 0x6f, 0x74,
+					0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20, 0x61, 0x73, 0x20, 0x61,
+					0x6e, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x69, 0x74, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x72, 0x75, 0x65, 0x20,
+					0x69, 0x66, 0x20, 0x64, 0x6f, 0x63, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63,
+					0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x2c, 0x20, 0x77, 0x68, 0x69, 0x63,
+					0x68, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x20, 0x72,
+					0x65, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x73, 0x20,
+					0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6b, 0x65, 0x79, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+					0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x64, 0x6f, 0x63, 0x20, 0x6d, 0x61, 0x70,
+					0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x28, 0x62, 0x6f,
+					0x6f, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b,
+					0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3a, 0x3d,
+					0x20, 0x31, 0x0a, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64,
+					0x20, 0x3a, 0x3d, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x6b, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x28, 0x64, 0x6f, 0x63, 0x2c, 0x20,
+					0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x3b, 0x20,
+					0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x76, 0x2c, 0x20, 0x6f, 0x6b,
+					0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+					0x65, 0x72, 0x28, 0x64, 0x6f, 0x63, 0x5b, 0x6b, 0x5d, 0x29, 0x0a, 0x09,
+					0x09, 0x69, 0x66, 0x20, 0x21, 0x6f, 0x6b, 0x20, 0x7c, 0x7c, 0x20, 0x76,
+					0x20, 0x3c, 0x20, 0x31, 0x20, 0x7c, 0x7c, 0x20, 0x76, 0x20, 0x21, 0x3d,
+					0x20, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x28, 0x69, 0x6e, 0x74,
+					0x28, 0x76, 0x29, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x20,
+					0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22,
+					0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20,
+					0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x25, 0x76, 0x20, 0x69, 0x73,
+					0x6e, 0x27, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x69,
+					0x74, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20,
+					0x61, 0x20, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x20, 0x69,
+					0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x2c, 0x20, 0x64, 0x6f, 0x63,
+					0x5b, 0x6b, 0x5d, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x76,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x20, 0x69, 0x6e, 0x74,
+					0x28, 0x76, 0x29, 0x0a, 0x09, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+					0x28, 0x64, 0x6f, 0x63, 0x2c, 0x20, 0x6b, 0x29, 0x0a, 0x09, 0x09, 0x6d,
+					0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x3d, 0x20, 0x74, 0x72,
+					0x75, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x76, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3e, 0x20, 0x53, 0x63, 0x68, 0x65,
+					0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x61, 0x6c,
+					0x73, 0x65, 0x2c, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x66, 0x28, 0x22, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x68, 0x61, 0x73, 0x20,
+					0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x25, 0x64, 0x2c, 0x20,
+					0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x65, 0x77,
+					0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69,
+					0x6f, 0x6e, 0x20, 0x25, 0x64, 0x22, 0x2c, 0x20, 0x76, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x5b,
+					0x5d, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b,
+					0x64, 0x6f, 0x63, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x6b, 0x2c, 0x20,
+					0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4b, 0x65,
+					0x79, 0x28, 0x64, 0x6f, 0x63, 0x2c, 0x20, 0x22, 0x44, 0x65, 0x66, 0x61,
+					0x75, 0x6c, 0x74, 0x73, 0x22, 0x29, 0x3b, 0x20, 0x6f, 0x6b, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x3d,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+					0x28, 0x64, 0x6f, 0x63, 0x5b, 0x6b, 0x5d, 0x2c, 0x20, 0x6e, 0x69, 0x6c,
+					0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x6b, 0x2c, 0x20, 0x6f, 0x6b,
+					0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x28,
+					0x64, 0x6f, 0x63, 0x2c, 0x20, 0x22, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x73, 0x22, 0x29, 0x3b, 0x20, 0x6f, 0x6b, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+					0x2c, 0x20, 0x5f, 0x20, 0x3a, 0x3d, 0x20, 0x64, 0x6f, 0x63, 0x5b, 0x6b,
+					0x5d, 0x2e, 0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c,
+					0x20, 0x6f, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20,
+					0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4f, 0x62,
+					0x6a, 0x65, 0x63, 0x74, 0x73, 0x28, 0x6f, 0x2c, 0x20, 0x6e, 0x69, 0x6c,
+					0x29, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09,
+					0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f,
+					0x2c, 0x20, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x6d, 0x2e, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3c, 0x3d, 0x20, 0x76, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x6f, 0x6e,
+					0x74, 0x69, 0x6e, 0x75, 0x65, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
+					0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x79,
+					0x28, 0x63, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20,
+					0x3d, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
+					0x65, 0x64, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x20, 0x61, 0x70, 0x70, 0x6c,
+					0x69, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x69, 0x67, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x2c, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20,
+					0x61, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x6d, 0x20,
+					0x2a, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20,
+					0x61, 0x70, 0x70, 0x6c, 0x79, 0x28, 0x63, 0x20, 0x6d, 0x61, 0x70, 0x5b,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+					0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20,
+					0x6f, 0x62, 0x6a, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+					0x28, 0x63, 0x2c, 0x20, 0x6d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x3a,
+					0x6d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5d, 0x29, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x70, 0x61, 0x74, 0x68, 0x20, 0x3a, 0x3d, 0x20, 0x6d,
+					0x2e, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x6d, 0x2e, 0x73, 0x68, 0x61, 0x72,
+					0x65, 0x64, 0x3a, 0x5d, 0x0a, 0x09, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63,
+					0x68, 0x20, 0x6d, 0x2e, 0x6f, 0x70, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x63,
+					0x61, 0x73, 0x65, 0x20, 0x22, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x3a, 0x0a,
+					0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x76, 0x2c, 0x20, 0x6f, 0x6b, 0x20,
+					0x3a, 0x3d, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x28,
+					0x6f, 0x62, 0x6a, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x29, 0x3b, 0x20,
+					0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x70, 0x75, 0x74,
+					0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x6f, 0x62, 0x6a, 0x2c, 0x20, 0x6d, 0x2e,
+					0x74, 0x6f, 0x5b, 0x6d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x3a,
+					0x5d, 0x2c, 0x20, 0x76, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09,
+					0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+					0x65, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x4a,
+					0x53, 0x4f, 0x4e, 0x28, 0x6f, 0x62, 0x6a, 0x2c, 0x20, 0x70, 0x61, 0x74,
+					0x68, 0x29, 0x0a, 0x09, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x63,
+					0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x09,
+					0x6b, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f,
+					0x6e, 0x4b, 0x65, 0x79, 0x28, 0x6f, 0x62, 0x6a, 0x2c, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x5b, 0x30, 0x5d, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x21, 0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x63,
+					0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x0a, 0x09, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x09, 0x76, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4a, 0x53, 0x4f,
+					0x4e, 0x28, 0x6f, 0x62, 0x6a, 0x5b, 0x6b, 0x5d, 0x2c, 0x20, 0x6d, 0x2e,
+					0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x29, 0x0a,
+					0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x25, 0x73, 0x3a, 0x20, 0x25, 0x76,
+					0x22, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4a,
+					0x6f, 0x69, 0x6e, 0x28, 0x6d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20,
+					0x22, 0x2e, 0x22, 0x29, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x09,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09, 0x6f, 0x62, 0x6a, 0x5b, 0x6b,
+					0x5d, 0x20, 0x3d, 0x20, 0x76, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4f,
+					0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+					0x74, 0x73, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x20, 0x69, 0x6e, 0x20, 0x76, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x73, 0x0a,
+					0x2f, 0x2f, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x6c,
+					0x6c, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
+					0x63, 0x74, 0x73, 0x28, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+					0x61, 0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20,
+					0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x5b, 0x5d,
+					0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x20, 0x7b,
+					0x0a, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x74, 0x76, 0x20,
+					0x3a, 0x3d, 0x20, 0x76, 0x2e, 0x28, 0x74, 0x79, 0x70, 0x65, 0x29, 0x20,
+					0x7b, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x5b,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+					0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x3a, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x6c, 0x65, 0x6e, 0x28, 0x70, 0x61, 0x74, 0x68, 0x29, 0x20, 0x3d,
+					0x3d, 0x20, 0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x5b, 0x5d, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x74, 0x76, 0x7d, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x6b, 0x2c, 0x20, 0x6f, 0x6b, 0x20,
+					0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x28, 0x74,
+					0x76, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x30, 0x5d, 0x29, 0x3b,
+					0x20, 0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
+					0x63, 0x74, 0x73, 0x28, 0x74, 0x76, 0x5b, 0x6b, 0x5d, 0x2c, 0x20, 0x70,
+					0x61, 0x74, 0x68, 0x5b, 0x31, 0x3a, 0x5d, 0x29, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x5b, 0x5d, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x3a, 0x0a, 0x09, 0x09,
+					0x76, 0x61, 0x72, 0x20, 0x72, 0x65, 0x73, 0x20, 0x5b, 0x5d, 0x6d, 0x61,
+					0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x09, 0x09, 0x66,
+					0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x76, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x72, 0x65, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e,
+					0x64, 0x28, 0x72, 0x65, 0x73, 0x2c, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4f,
+					0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x28, 0x65, 0x2c, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x29, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x73,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x61,
+					0x6b, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+					0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68,
+					0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6d, 0x2c, 0x20, 0x61, 0x6e, 0x64,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x69, 0x74, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x4a, 0x53, 0x4f,
+					0x4e, 0x28, 0x6d, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+					0x7b, 0x7d, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x5b, 0x5d, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x62, 0x6f, 0x6f,
+					0x6c, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x6b, 0x2c, 0x20, 0x6f, 0x6b, 0x20,
+					0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x28, 0x6d,
+					0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x30, 0x5d, 0x29, 0x0a, 0x09,
+					0x69, 0x66, 0x20, 0x21, 0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x66,
+					0x61, 0x6c, 0x73, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x6c, 0x65, 0x6e, 0x28, 0x70, 0x61, 0x74, 0x68, 0x29, 0x20, 0x3e, 0x20,
+					0x31, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2c,
+					0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x5b, 0x6b, 0x5d, 0x2e,
+					0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x21, 0x6f, 0x6b, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69,
+					0x6c, 0x2c, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x61,
+					0x6b, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x63, 0x68, 0x69, 0x6c, 0x64,
+					0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x31, 0x3a, 0x5d, 0x29, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x76, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x5b, 0x6b,
+					0x5d, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x28, 0x6d, 0x2c,
+					0x20, 0x6b, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x76, 0x2c, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x70, 0x75, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x73, 0x65,
+					0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68,
+					0x20, 0x69, 0x6e, 0x20, 0x6d, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f,
+					0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x61, 0x72,
+					0x65, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x0a, 0x2f, 0x2f,
+					0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x64, 0x6f, 0x6e,
+					0x27, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2c, 0x20, 0x61, 0x6e,
+					0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x72, 0x65,
+					0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x70, 0x75, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x6d, 0x20, 0x6d, 0x61,
+					0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c,
+					0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+					0x7b, 0x7d, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x6b, 0x2c, 0x20, 0x6f, 0x6b,
+					0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x28,
+					0x6d, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x30, 0x5d, 0x29, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x70, 0x61, 0x74, 0x68,
+					0x29, 0x20, 0x3d, 0x3d, 0x20, 0x31, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x21, 0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x6d,
+					0x5b, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x30, 0x5d, 0x5d, 0x20, 0x3d, 0x20,
+					0x76, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x21, 0x6f,
+					0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6b, 0x20, 0x3d, 0x20, 0x70, 0x61,
+					0x74, 0x68, 0x5b, 0x30, 0x5d, 0x0a, 0x09, 0x09, 0x6d, 0x5b, 0x6b, 0x5d,
+					0x20, 0x3d, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x7b, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x63,
+					0x68, 0x69, 0x6c, 0x64, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20,
+					0x6d, 0x5b, 0x6b, 0x5d, 0x2e, 0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x29, 0x3b, 0x20, 0x6f, 0x6b, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x70, 0x75, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x63, 0x68,
+					0x69, 0x6c, 0x64, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x5b, 0x31, 0x3a,
+					0x5d, 0x2c, 0x20, 0x76, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x2f, 0x2f, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4a, 0x53,
+					0x4f, 0x4e, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x73, 0x20,
+					0x76, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+					0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+					0x20, 0x61, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61,
+					0x74, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x0a, 0x2f, 0x2f,
+					0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x65,
+					0x77, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x75,
+					0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4a, 0x53, 0x4f,
+					0x4e, 0x28, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20,
+					0x28, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x61, 0x73,
+					0x65, 0x20, 0x22, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2c,
+					0x20, 0x22, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+					0x64, 0x73, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x6e, 0x2c,
+					0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x4e,
+					0x75, 0x6d, 0x62, 0x65, 0x72, 0x28, 0x76, 0x29, 0x3b, 0x20, 0x6f, 0x6b,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x20, 0x3a,
+					0x3d, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+					0x64, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x76,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x6d,
+					0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x20,
+					0x3d, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+					0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x69,
+					0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28,
+					0x6e, 0x20, 0x2a, 0x20, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x28,
+					0x75, 0x6e, 0x69, 0x74, 0x29, 0x29, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x28, 0x29, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x7d,
+					0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x22, 0x3a, 0x0a, 0x09, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63,
+					0x68, 0x20, 0x74, 0x76, 0x20, 0x3a, 0x3d, 0x20, 0x76, 0x2e, 0x28, 0x74,
+					0x79, 0x70, 0x65, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x63, 0x61, 0x73,
+					0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+					0x72, 0x3a, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x74, 0x76, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29,
+					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x63, 0x61, 0x73, 0x65,
+					0x20, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x3a, 0x0a, 0x09, 0x09,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x63,
+					0x6f, 0x6e, 0x76, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x46, 0x6c,
+					0x6f, 0x61, 0x74, 0x28, 0x74, 0x76, 0x2c, 0x20, 0x27, 0x66, 0x27, 0x2c,
+					0x20, 0x2d, 0x31, 0x2c, 0x20, 0x36, 0x34, 0x29, 0x2c, 0x20, 0x6e, 0x69,
+					0x6c, 0x0a, 0x09, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x62, 0x6f, 0x6f,
+					0x6c, 0x3a, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x2e, 0x46, 0x6f, 0x72,
+					0x6d, 0x61, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x28, 0x74, 0x76, 0x29, 0x2c,
+					0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x63, 0x61,
+					0x73, 0x65, 0x20, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3a,
+					0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x2c, 0x20, 0x6f, 0x6b, 0x20,
+					0x3a, 0x3d, 0x20, 0x76, 0x2e, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x3b, 0x20, 0x6f, 0x6b, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
+					0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x2e, 0x50, 0x61, 0x72, 0x73,
+					0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x28, 0x73, 0x2c, 0x20, 0x36, 0x34,
+					0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
+					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x66, 0x6d, 0x74, 0x2e,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x25, 0x71, 0x20, 0x69,
+					0x73, 0x6e, 0x27, 0x74, 0x20, 0x61, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+					0x72, 0x22, 0x2c, 0x20, 0x73, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75,
+					0x6d, 0x62, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6b, 0x65, 0x70, 0x74,
+					0x20, 0x61, 0x73, 0x20, 0x69, 0x74, 0x27, 0x73, 0x20, 0x77, 0x72, 0x69,
+					0x74, 0x74, 0x65, 0x6e, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61,
+					0x74, 0x20, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x67, 0x65, 0x72, 0x73, 0x20, 0x64, 0x6f, 0x6e, 0x27, 0x74, 0x20, 0x6c,
+					0x6f, 0x73, 0x65, 0x20, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+					0x6e, 0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28,
+					0x73, 0x29, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x76,
+					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76,
+					0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x76, 0x20, 0x69, 0x66,
+					0x20, 0x69, 0x74, 0x27, 0x73, 0x20, 0x61, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+					0x65, 0x72, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+					0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28, 0x76, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x28, 0x66,
+					0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20,
+					0x74, 0x76, 0x20, 0x3a, 0x3d, 0x20, 0x76, 0x2e, 0x28, 0x74, 0x79, 0x70,
+					0x65, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x66,
+					0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x3a, 0x0a, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x76, 0x2c, 0x20, 0x74, 0x72, 0x75,
+					0x65, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+					0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x0a, 0x09, 0x09, 0x66,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x74, 0x76, 0x2e,
+					0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x28, 0x29, 0x0a, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x30, 0x2c, 0x20, 0x66,
+					0x61, 0x6c, 0x73, 0x65, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6c, 0x6f, 0x61,
+					0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x69, 0x6c,
+					0x65, 0x73, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65,
+					0x74, 0x73, 0x20, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+					0x64, 0x20, 0x61, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f,
+					0x3c, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x3e, 0x20, 0x62,
+					0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x20,
+					0x6f, 0x72, 0x0a, 0x2f, 0x2f, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+					0x6f, 0x72, 0x73, 0x2c, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x69, 0x73,
+					0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x64,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20,
+					0x5b, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x20, 0x70, 0x72, 0x65,
+					0x66, 0x69, 0x78, 0x5d, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x76, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
+					0x28, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x72,
+					0x65, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76,
+					0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d,
+					0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3d, 0x20, 0x6d, 0x61,
+					0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x7d,
+					0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28,
+					0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x30, 0x2c,
+					0x20, 0x6c, 0x65, 0x6e, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+					0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x29, 0x2b, 0x6c, 0x65, 0x6e, 0x28,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+					0x63, 0x74, 0x6f, 0x72, 0x73, 0x29, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72,
+					0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2c, 0x20, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
+					0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x73, 0x65, 0x6c, 0x20, 0x3a,
+					0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+					0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
+					0x28, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2c, 0x20,
+					0x73, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+					0x63, 0x61, 0x63, 0x68, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x70,
+					0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x62, 0x6f, 0x6f, 0x6c,
+					0x7b, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x50, 0x72, 0x65,
+					0x66, 0x69, 0x78, 0x28, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x2c, 0x20, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x22, 0x29,
+					0x20, 0x26, 0x26, 0x20, 0x21, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x63,
+					0x61, 0x63, 0x68, 0x65, 0x5b, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x5d, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x6d,
+					0x61, 0x72, 0x6b, 0x20, 0x61, 0x73, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+					0x64, 0x0a, 0x09, 0x09, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x63,
+					0x61, 0x63, 0x68, 0x65, 0x5b, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x5d, 0x20, 0x3d, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x0a, 0x09,
+					0x09, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x3a, 0x3d, 0x20,
+					0x6e, 0x65, 0x77, 0x28, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x28, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5b, 0x37, 0x3a,
+					0x5d, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x29, 0x3b, 0x20,
 					0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
-					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72,
-					0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20,
-					0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e,
-					0x65, 0x77, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x66, 0x29,
-					0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x0a, 0x7d,
-					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c,
-					0x76, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x62, 0x61, 0x73,
-					0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x20,
-					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72,
-					0x69, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
-					0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x21, 0x66, 0x69, 0x6c, 0x65, 0x70,
-					0x61, 0x74, 0x68, 0x2e, 0x49, 0x73, 0x41, 0x62, 0x73, 0x28, 0x63, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x29, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x2f, 0x2f, 0x20, 0x69, 0x66, 0x20, 0x72, 0x65, 0x6c, 0x61,
-					0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x6e, 0x6f,
-					0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2c, 0x20, 0x74, 0x72, 0x79,
-					0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x20,
-					0x69, 0x74, 0x20, 0x61, 0x73, 0x20, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-					0x76, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x61,
-					0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69,
-					0x6c, 0x65, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x5f, 0x2c, 0x20, 0x65,
-					0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x61,
-					0x74, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65,
-					0x29, 0x3b, 0x20, 0x6f, 0x73, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x45,
-					0x78, 0x69, 0x73, 0x74, 0x28, 0x65, 0x72, 0x72, 0x29, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x69, 0x72, 0x20, 0x3a,
-					0x3d, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x44,
-					0x69, 0x72, 0x28, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-					0x67, 0x46, 0x69, 0x6c, 0x65, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x3d, 0x20, 0x66,
-					0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
-					0x28, 0x62, 0x61, 0x73, 0x65, 0x44, 0x69, 0x72, 0x2c, 0x20, 0x63, 0x6f,
+					0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d,
+					0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x44, 0x65, 0x70, 0x72,
+					0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7d, 0x7d, 0x0a, 0x09,
+					0x09, 0x09, 0x76, 0x61, 0x72, 0x20, 0x72, 0x61, 0x77, 0x20, 0x69, 0x6e,
+					0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x09, 0x09,
+					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c,
+					0x6f, 0x61, 0x64, 0x28, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x5b, 0x37, 0x3a, 0x5d, 0x2c, 0x20, 0x26, 0x72, 0x61, 0x77, 0x29, 0x3b,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
+					0x09, 0x6e, 0x65, 0x77, 0x28, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x2e, 0x64, 0x65, 0x70, 0x72,
+					0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x22, 0x22, 0x2c,
+					0x20, 0x72, 0x61, 0x77, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x77, 0x61, 0x72, 0x6e, 0x28, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x5b, 0x37, 0x3a, 0x5d, 0x29, 0x29, 0x0a, 0x7b, 0x7b,
+					0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x09, 0x09, 0x09,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x5d, 0x20, 0x3d, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f,
+					0x4e, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+					0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09,
+					0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
+					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x64, 0x65, 0x66,
+					0x65, 0x72, 0x20, 0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6a, 0x73, 0x6f,
+					0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72,
+					0x28, 0x66, 0x29, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x76,
+					0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x72, 0x65,
+					0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x28,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x2c, 0x20,
+					0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
+					0x6c, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x21, 0x66, 0x69,
+					0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x73, 0x41, 0x62, 0x73,
+					0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x69, 0x66, 0x20, 0x72,
+					0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65,
+					0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2c, 0x20,
+					0x74, 0x72, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+					0x76, 0x65, 0x20, 0x69, 0x74, 0x20, 0x61, 0x73, 0x20, 0x72, 0x65, 0x6c,
+					0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x62, 0x61, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x20, 0x66, 0x69, 0x6c, 0x65, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x5f,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x73, 0x2e,
+					0x53, 0x74, 0x61, 0x74, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
+					0x69, 0x6c, 0x65, 0x29, 0x3b, 0x20, 0x6f, 0x73, 0x2e, 0x49, 0x73, 0x4e,
+					0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x28, 0x65, 0x72, 0x72, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x69,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74,
+					0x68, 0x2e, 0x44, 0x69, 0x72, 0x28, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
 					0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x29, 0x0a, 0x09, 0x09,
-					0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65,
-					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
-					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e,
-					0x74, 0x69, 0x72, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20,
+					0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x20,
+					0x3d, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x4a,
+					0x6f, 0x69, 0x6e, 0x28, 0x62, 0x61, 0x73, 0x65, 0x44, 0x69, 0x72, 0x2c,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x29,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
+					0x69, 0x6c, 0x65, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f,
+					0x4e, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x28, 0x66, 0x69, 0x6c, 0x65,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c,
+					0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+					0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a,
+					0x09, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6f,
+					0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+					0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x66, 0x2e, 0x43,
+					0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x64, 0x20, 0x3a, 0x3d,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x28, 0x66, 0x29, 0x0a, 0x09, 0x64, 0x2e, 0x44,
+					0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+					0x77, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x28, 0x29, 0x0a, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x64, 0x2e, 0x44, 0x65, 0x63,
+					0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x46,
+					0x53, 0x28, 0x66, 0x73, 0x79, 0x73, 0x20, 0x66, 0x73, 0x2e, 0x46, 0x53,
+					0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20,
+					0x7b, 0x0a, 0x09, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x66, 0x73, 0x79, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x28, 0x6e,
+					0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x66, 0x2e, 0x43,
+					0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x66, 0x29, 0x2e, 0x44, 0x65,
+					0x63, 0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
+					0x69, 0x6c, 0x65, 0x46, 0x53, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x73, 0x20, 0x72, 0x65, 0x73,
+					0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x2c, 0x20,
+					0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x69,
+					0x6e, 0x20, 0x66, 0x73, 0x79, 0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c,
+					0x65, 0x46, 0x53, 0x28, 0x66, 0x73, 0x79, 0x73, 0x20, 0x66, 0x73, 0x2e,
+					0x46, 0x53, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
+					0x6c, 0x65, 0x2c, 0x20, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x66, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x28, 0x66, 0x73,
+					0x79, 0x73, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69,
+					0x6c, 0x65, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x69,
+					0x66, 0x20, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
+					0x64, 0x2c, 0x20, 0x74, 0x72, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65,
+					0x73, 0x6f, 0x6c, 0x76, 0x65, 0x20, 0x69, 0x74, 0x20, 0x61, 0x73, 0x20,
+					0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x74, 0x6f, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x62, 0x61, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x0a, 0x09, 0x09, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x3d, 0x20,
+					0x70, 0x61, 0x74, 0x68, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x28, 0x70, 0x61,
+					0x74, 0x68, 0x2e, 0x44, 0x69, 0x72, 0x28, 0x62, 0x61, 0x73, 0x65, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x29, 0x2c, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x29, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x73, 0x65, 0x74,
+					0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x73,
+					0x65, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
+					0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x61, 0x20, 0x62, 0x61, 0x73,
+					0x65, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a,
+					0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x61, 0x20, 0x73, 0x65, 0x74, 0x20,
+					0x6f, 0x66, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x2d, 0x3e, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x73, 0x20, 0x2d, 0x3e, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+					0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x73, 0x74, 0x72,
+					0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x44, 0x65,
+					0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+					0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x61, 0x73, 0x65, 0x20,
 					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x73, 0x65, 0x20, 0x63, 0x6f,
-					0x6e, 0x73, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x0a, 0x2f, 0x2f, 0x20,
-					0x20, 0x20, 0x20, 0x61, 0x20, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x64, 0x65,
-					0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x2f, 0x2f, 0x20, 0x20,
-					0x20, 0x20, 0x61, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x68,
-					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x2d, 0x3e, 0x20, 0x6e,
-					0x61, 0x6d, 0x65, 0x64, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-					0x65, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x6e, 0x61, 0x6d,
-					0x65, 0x64, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
-					0x20, 0x2d, 0x3e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x6f,
-					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x0a, 0x74, 0x79, 0x70,
-					0x65, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20,
-					0x7b, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-					0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x74,
-					0x68, 0x65, 0x20, 0x62, 0x61, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66,
-					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x74,
-					0x68, 0x69, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x20,
-					0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x69, 0x74, 0x20, 0x6f, 0x76,
-					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20,
-					0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x63, 0x20, 0x6e, 0x61, 0x6d, 0x65,
-					0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x0a, 0x09, 0x44, 0x65,
-					0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x0a, 0x09, 0x2f,
-					0x2f, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x68,
-					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x6e,
-					0x61, 0x6d, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74,
-					0x73, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-					0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x0a, 0x09, 0x2f, 0x2f,
-					0x20, 0x61, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x61,
-					0x6d, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x73, 0x2c, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x69, 0x66, 0x20,
-					0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
-					0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x20, 0x70, 0x72, 0x65, 0x66,
-					0x69, 0x78, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x65, 0x78, 0x74,
-					0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x77,
-					0x69, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65,
-					0x64, 0x0a, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+					0x6e, 0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c,
+					0x69, 0x65, 0x73, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x69,
+					0x74, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x62,
+					0x79, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x63, 0x20,
+					0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x0a, 0x09, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x0a, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x70, 0x20,
+					0x6f, 0x66, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x74, 0x6f, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x09,
+					0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a,
+					0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x6f,
+					0x66, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2c, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x69, 0x66, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x77,
+					0x69, 0x74, 0x68, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x20,
+					0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e,
+					0x20, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x6c,
+					0x6f, 0x61, 0x64, 0x65, 0x64, 0x0a, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x5d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x61,
+					0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6c, 0x61, 0x62,
+					0x65, 0x6c, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+					0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+					0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x0a,
+					0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65,
+					0x6c, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69,
+					0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2c,
+					0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x6f, 0x72,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+					0x20, 0x5b, 0x5d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20,
+					0x60, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+					0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x60, 0x0a, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+					0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20,
+					0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x20,
+					0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73,
+					0x0a, 0x09, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x5b,
+					0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x77, 0x61, 0x72,
+					0x6e, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x61, 0x63,
+					0x68, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x70,
+					0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x6b, 0x65, 0x79, 0x20,
+					0x6f, 0x66, 0x20, 0x61, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x74,
+					0x68, 0x61, 0x74, 0x27, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69,
+					0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+					0x3a, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x41,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x77, 0x61, 0x73, 0x20, 0x72,
+					0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x42, 0x69,
+					0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x29, 0x20, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x28, 0x29,
+					0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a,
+					0x09, 0x72, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65,
+					0x6e, 0x64, 0x28, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28,
+					0x6e, 0x69, 0x6c, 0x29, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x2e,
+					0x2e, 0x29, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x73, 0x28, 0x72, 0x65, 0x73, 0x29, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x73, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x44, 0x65, 0x70,
+					0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7d, 0x7d, 0x0a,
+					0x2f, 0x2f, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x64, 0x64, 0x73,
+					0x20, 0x61, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x66,
+					0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x28,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x77, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x77, 0x20, 0x3d, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x2b,
+					0x20, 0x22, 0x3a, 0x20, 0x22, 0x20, 0x2b, 0x20, 0x77, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x3d, 0x20, 0x61,
+					0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x2c, 0x20,
+					0x77, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x61, 0x64, 0x64, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20,
+					0x61, 0x64, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x61, 0x72,
+					0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20,
+					0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74,
+					0x20, 0x61, 0x72, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x0a, 0x2f, 0x2f,
+					0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x44, 0x65, 0x66, 0x61,
+					0x75, 0x6c, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x76, 0x2c,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
+					0x20, 0x61, 0x64, 0x64, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+					0x28, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x77, 0x61, 0x72, 0x6e,
+					0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x77, 0x61, 0x72, 0x6e, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09,
+					0x6d, 0x2c, 0x20, 0x5f, 0x20, 0x3a, 0x3d, 0x20, 0x76, 0x2e, 0x28, 0x6d,
+					0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e,
+					0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x0a, 0x09,
+					0x66, 0x6f, 0x72, 0x20, 0x6b, 0x2c, 0x20, 0x66, 0x76, 0x20, 0x3a, 0x3d,
+					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6d, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x63, 0x61, 0x73, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x28, 0x6b,
+					0x2c, 0x20, 0x22, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22,
+					0x29, 0x3a, 0x0a, 0x09, 0x09, 0x09, 0x6e, 0x65, 0x77, 0x28, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29,
+					0x2e, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x28, 0x6b, 0x2b, 0x22, 0x2e, 0x22, 0x2c, 0x20, 0x66, 0x76, 0x2c,
+					0x20, 0x77, 0x61, 0x72, 0x6e, 0x29, 0x0a, 0x09, 0x09, 0x63, 0x61, 0x73,
+					0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x28, 0x6b, 0x2c, 0x20, 0x22,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x29, 0x3a,
+					0x0a, 0x09, 0x09, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x73, 0x2c, 0x20, 0x5f, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x76, 0x2e, 0x28,
+					0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x0a,
+					0x09, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x6e, 0x2c, 0x20, 0x6f, 0x20,
+					0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
+					0x09, 0x6e, 0x65, 0x77, 0x28, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x2e, 0x64, 0x65, 0x70, 0x72,
+					0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x6b, 0x2b, 0x22,
+					0x2e, 0x22, 0x2b, 0x6e, 0x2b, 0x22, 0x2e, 0x22, 0x2c, 0x20, 0x6f, 0x2c,
+					0x20, 0x77, 0x61, 0x72, 0x6e, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20,
+					0x2e, 0x48, 0x61, 0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x69, 0x78,
+					0x65, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61,
+					0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x69, 0x66, 0x20, 0x61,
+					0x6e, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x73, 0x20,
+					0x73, 0x65, 0x74, 0x20, 0x61, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x0a, 0x2f,
+					0x2f, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x64, 0x65, 0x6e, 0x2c, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69,
+					0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x6f, 0x61,
+					0x64, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x66, 0x69, 0x6c,
+					0x65, 0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x63, 0x68,
+					0x65, 0x63, 0x6b, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x29, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+					0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28, 0x5b, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x30, 0x2c, 0x20, 0x6c,
+					0x65, 0x6e, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x29, 0x29, 0x0a, 0x09,
+					0x66, 0x6f, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x20, 0x3d, 0x20,
+					0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+					0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
+					0x73, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+					0x28, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72,
+					0x20, 0x5f, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x5b, 0x6e, 0x61, 0x6d, 0x65, 0x5d, 0x0a, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x66, 0x69, 0x78, 0x65, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x6f,
+					0x2e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x53, 0x65, 0x74, 0x28, 0x22, 0x22,
+					0x29, 0x3b, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x66, 0x69, 0x78, 0x65, 0x64,
+					0x29, 0x20, 0x3e, 0x20, 0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x25, 0x73, 0x20, 0x73, 0x65,
+					0x74, 0x73, 0x20, 0x25, 0x73, 0x2c, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68,
+					0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+					0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x28, 0x66, 0x69, 0x78, 0x65, 0x64, 0x2c,
+					0x20, 0x22, 0x2c, 0x20, 0x22, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e,
+					0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x53, 0x65, 0x6c, 0x65, 0x63,
+					0x74, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x20,
+					0x61, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f,
+					0x6e, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x2c, 0x20, 0x65, 0x2e,
+					0x67, 0x2e, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+					0x6e, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
+					0x72, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20,
+					0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x73, 0x74, 0x72,
+					0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x4d, 0x61,
+					0x74, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x61, 0x6c,
+					0x6c, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+					0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c,
+					0x75, 0x65, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x20,
+					0x61, 0x70, 0x70, 0x6c, 0x79, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68,
 					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
-					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x48, 0x6f, 0x73, 0x74,
-					0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x65,
-					0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
-					0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x26, 0x20, 0x6f,
-					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20,
-					0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x75, 0x73,
-					0x65, 0x64, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x48, 0x6f, 0x73, 0x74,
-					0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74,
-					0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x48,
-					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75,
-					0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
-					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
-					0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x61, 0x73,
-					0x20, 0x75, 0x73, 0x65, 0x64, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68,
-					0x69, 0x73, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x71, 0x75,
-					0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74,
-					0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6a,
-					0x75, 0x73, 0x74, 0x20, 0x61, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x77,
-					0x61, 0x73, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
-					0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
-					0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x4f, 0x76,
-					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-					0x69, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65,
-					0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72,
-					0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-					0x2c, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x20, 0x77,
-					0x61, 0x73, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
-					0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x5b, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20,
-					0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x6e,
-					0x61, 0x6d, 0x65, 0x5d, 0x0a, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x2f, 0x2f, 0x20, 0x46, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20,
+					0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79,
+					0x0a, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x73, 0x20, 0x74, 0x72, 0x75, 0x65, 0x20, 0x69, 0x66, 0x20,
+					0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65,
+					0x6c, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65,
+					0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x27, 0x73, 0x20, 0x4d, 0x61, 0x74,
+					0x63, 0x68, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x73, 0x61, 0x6d, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69,
+					0x6e, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x28, 0x73, 0x20, 0x2a, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+					0x6f, 0x72, 0x29, 0x20, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28,
+					0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x29, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66,
+					0x20, 0x6c, 0x65, 0x6e, 0x28, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+					0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x6b, 0x2c, 0x20, 0x76,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x73, 0x2e,
+					0x4d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x6c, 0x76, 0x2c, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x20,
+					0x3a, 0x3d, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x5b, 0x6b, 0x5d,
+					0x3b, 0x20, 0x21, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x20, 0x7c, 0x7c,
+					0x20, 0x6c, 0x76, 0x20, 0x21, 0x3d, 0x20, 0x76, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x61, 0x6c,
+					0x73, 0x65, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x46,
+					0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65,
+					0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x76,
+					0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61,
+					0x74, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x2c, 0x0a, 0x2f, 0x2f, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65,
+					0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61,
+					0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x69, 0x6e, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x63, 0x61, 0x73,
+					0x65, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x20, 0x61, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x6f, 0x66,
+					0x0a, 0x2f, 0x2f, 0x20, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4c,
+					0x41, 0x42, 0x45, 0x4c, 0x5f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61,
+					0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+					0x47, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x47, 0x49,
+					0x4f, 0x4e, 0x3d, 0x75, 0x73, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x20, 0x73,
+					0x65, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65,
+					0x6c, 0x20, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20,
+					0x75, 0x73, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x45,
+					0x6e, 0x76, 0x28, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20,
+					0x7b, 0x0a, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x7d, 0x0a, 0x09, 0x66, 0x6f,
+					0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6b, 0x76, 0x20, 0x3a, 0x3d, 0x20, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x69,
+					0x72, 0x6f, 0x6e, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x21, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61,
+					0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x28, 0x6b, 0x76, 0x2c, 0x20,
+					0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x69, 0x20, 0x3a, 0x3d, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+					0x78, 0x42, 0x79, 0x74, 0x65, 0x28, 0x6b, 0x76, 0x2c, 0x20, 0x27, 0x3d,
+					0x27, 0x29, 0x3b, 0x20, 0x69, 0x20, 0x3e, 0x20, 0x6c, 0x65, 0x6e, 0x28,
+					0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x5b, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x73, 0x2e, 0x54, 0x6f, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x28,
+					0x6b, 0x76, 0x5b, 0x6c, 0x65, 0x6e, 0x28, 0x70, 0x72, 0x65, 0x66, 0x69,
+					0x78, 0x29, 0x3a, 0x69, 0x5d, 0x29, 0x5d, 0x20, 0x3d, 0x20, 0x6b, 0x76,
+					0x5b, 0x69, 0x2b, 0x31, 0x3a, 0x5d, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x48,
+					0x6f, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+					0x26, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73,
+					0x65, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x65, 0x72, 0x65,
+					0x20, 0x75, 0x73, 0x65, 0x64, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x48,
+					0x6f, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x2f,
+					0x2f, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+					0x77, 0x61, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x79,
+					0x20, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x77, 0x68, 0x65,
+					0x6e, 0x20, 0x6a, 0x75, 0x73, 0x74, 0x20, 0x61, 0x20, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+					0x69, 0x65, 0x64, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x63, 0x74,
+					0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x72,
+					0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x66, 0x6f,
+					0x75, 0x6e, 0x64, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x5b, 0x62, 0x61, 0x73,
+					0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f,
+					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5d, 0x0a, 0x09, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+					0x72, 0x73, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+					0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68,
+					0x65, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x73, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x61,
+					0x72, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x69,
+					0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72,
+					0x2c, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+					0x73, 0x20, 0x5b, 0x5d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x46, 0x6f, 0x72, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x64,
+					0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x2c,
+					0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20,
+					0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x2e, 0x0a, 0x2f, 0x2f, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+					0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x64, 0x65, 0x65, 0x70,
+					0x20, 0x63, 0x6f, 0x70, 0x79, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x64, 0x6f,
+					0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20,
+					0x61, 0x6e, 0x79, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x20, 0x6f,
+					0x72, 0x20, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x20, 0x77,
+					0x69, 0x74, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x73,
+					0x20, 0x64, 0x65, 0x72, 0x76, 0x69, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f,
+					0x6d, 0x20, 0x5b, 0x69, 0x6e, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5d,
+					0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x31, 0x29, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d,
+					0x65, 0x74, 0x65, 0x72, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+					0x22, 0x22, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x32, 0x29, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+					0x65, 0x6d, 0x6e, 0x74, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+					0x65, 0x20, 0x69, 0x6e, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e,
+					0x61, 0x6d, 0x65, 0x2c, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+					0x22, 0x22, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x33, 0x29, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x4f, 0x53, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c,
+					0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x46, 0x6f, 0x72, 0x28,
+					0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29,
+					0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x4c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e,
+					0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2c, 0x20, 0x6e,
+					0x69, 0x6c, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x46, 0x6f,
+					0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75,
 					0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x43, 0x6f, 0x6e, 0x66,
 					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f,
 					0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61,
-					0x74, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x2c, 0x20, 0x77, 0x69,
-					0x74, 0x68, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f,
-					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x61, 0x70, 0x70,
-					0x6c, 0x69, 0x65, 0x64, 0x2e, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65,
-					0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x75, 0x73,
-					0x65, 0x64, 0x20, 0x69, 0x73, 0x20, 0x64, 0x65, 0x72, 0x76, 0x69, 0x65,
-					0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x5b, 0x69, 0x6e, 0x20, 0x6f,
-					0x72, 0x64, 0x65, 0x72, 0x5d, 0x0a, 0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20,
-					0x31, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-					0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20,
-					0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x20, 0x69, 0x66,
-					0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a, 0x2f, 0x2f, 0x20, 0x20,
-					0x20, 0x20, 0x32, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c,
-					0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x45, 0x6e,
-					0x76, 0x69, 0x72, 0x6f, 0x6e, 0x65, 0x6d, 0x6e, 0x74, 0x20, 0x76, 0x61,
-					0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x65, 0x6e,
-					0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x69, 0x66,
-					0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a, 0x2f, 0x2f, 0x20, 0x20,
-					0x20, 0x20, 0x33, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x53, 0x20,
-					0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73,
-					0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28,
-					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x43, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
-					0x20, 0x46, 0x6f, 0x72, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e,
-					0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-					0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x74,
+					0x74, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64,
+					0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x0a, 0x2f,
+					0x2f, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61,
+					0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63,
+					0x74, 0x6f, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x70, 0x70,
+					0x6c, 0x69, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x6f, 0x72, 0x64, 0x65,
+					0x72, 0x2c, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20,
+					0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x2e, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x69, 0x73, 0x20, 0x61, 0x20, 0x64, 0x65, 0x65, 0x70, 0x20, 0x63, 0x6f,
+					0x70, 0x79, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e,
+					0x27, 0x74, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x61, 0x6e, 0x79,
+					0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20,
+					0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x29, 0x20, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65,
+					0x6c, 0x73, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d,
+					0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x6d,
+					0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74,
 					0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x2a, 0x43, 0x6f, 0x6e, 0x66,
 					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65,
 					0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x73, 0x65, 0x6c,
 					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e,
 					0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
-					0x6f, 0x6e, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d,
-					0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f,
-					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a, 0x09, 0x69, 0x66,
-					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
-					0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e,
-					0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
-					0x63, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-					0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x0a, 0x09, 0x69,
-					0x66, 0x20, 0x73, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09,
+					0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+					0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c,
+					0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x2c, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x73, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x29, 0x0a,
+					0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x73, 0x65, 0x6c, 0x2e, 0x53,
+					0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x20, 0x7b, 0x0a, 0x09,
 					0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3a,
 					0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76,
-					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x73, 0x65, 0x6c, 0x2e,
-					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5d, 0x0a, 0x09, 0x09,
-					0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x72,
-					0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
-					0x73, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x26, 0x63, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x2f, 0x2f, 0x20, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68,
-					0x65, 0x20, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x73, 0x6f,
-					0x6c, 0x76, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-					0x65, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x66, 0x20, 0x61, 0x70,
-					0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x2c, 0x0a, 0x2f, 0x2f,
-					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65,
-					0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x66,
-					0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c,
-					0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x73, 0x70, 0x65,
-					0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x73, 0x2e, 0x4f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5d, 0x0a, 0x09, 0x09, 0x63, 0x2e,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+					0x28, 0x26, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x73, 0x65, 0x6c, 0x2e,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x21, 0x3d, 0x20,
+					0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x73, 0x5b, 0x73, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x5d, 0x0a, 0x09, 0x09, 0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x29, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x2c, 0x20, 0x6e,
+					0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x53, 0x65, 0x6c,
+					0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+					0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x68, 0x6f,
+					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20,
+					0x69, 0x66, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c,
+					0x65, 0x2c, 0x0a, 0x2f, 0x2f, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x20, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6e,
+					0x61, 0x6d, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73,
+					0x74, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x53, 0x65, 0x6c, 0x65,
+					0x63, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79,
+					0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x48, 0x6f, 0x73, 0x74,
+					0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74,
+					0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x65, 0x6e, 0x76, 0x4b,
+					0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+					0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6e,
+					0x61, 0x6c, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x61, 0x6e,
+					0x64, 0x20, 0x69, 0x66, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+					0x62, 0x6c, 0x65, 0x2c, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+					0x72, 0x73, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x20, 0x74, 0x68,
+					0x61, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x0a, 0x66, 0x75, 0x6e, 0x63,
 					0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x43,
 					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 					0x73, 0x29, 0x20, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-					0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c,
-					0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65,
-					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x65,
+					0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20,
+					0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
 					0x29, 0x20, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63,
 					0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
 					0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x48,
@@ -742,116 +4486,142 @@ func init() {
 					0x2c, 0x20, 0x68, 0x6e, 0x2c, 0x20, 0x6f, 0x76, 0x29, 0x0a, 0x09, 0x09,
 					0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72,
 					0x72, 0x69, 0x64, 0x65, 0x20, 0x3d, 0x20, 0x6f, 0x76, 0x0a, 0x09, 0x7d,
-					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x73,
-					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
-					0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x6e,
-					0x61, 0x6d, 0x65, 0x20, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-					0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-					0x61, 0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
-					0x70, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x65, 0x20, 0x69,
-					0x66, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x27, 0x73,
-					0x20, 0x61, 0x6e, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
-					0x20, 0x73, 0x65, 0x74, 0x20, 0x77, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75,
-					0x6c, 0x64, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x0a, 0x2f, 0x2f, 0x20,
-					0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-					0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x73, 0x20, 0x64, 0x65, 0x72,
-					0x76, 0x69, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x5b, 0x69,
-					0x6e, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5d, 0x0a, 0x2f, 0x2f, 0x20,
-					0x20, 0x20, 0x20, 0x31, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f,
-					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-					0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a, 0x2f,
-					0x2f, 0x20, 0x20, 0x20, 0x20, 0x32, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20,
-					0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
-					0x20, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x65, 0x6d, 0x6e, 0x74,
-					0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x69, 0x6e,
-					0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x2c,
-					0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a, 0x2f,
-					0x2f, 0x20, 0x20, 0x20, 0x20, 0x33, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20,
-					0x4f, 0x53, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20,
-					0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x2f, 0x2f, 0x20,
-					0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c,
-					0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-					0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x65, 0x78, 0x69,
-					0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f,
-					0x74, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x71, 0x75,
-					0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65,
-					0x0a, 0x2f, 0x2f, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x72,
-					0x65, 0x27, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79,
-					0x20, 0x69, 0x6e, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x74, 0x68,
-					0x61, 0x74, 0x20, 0x69, 0x73, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20,
-					0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2c, 0x20, 0x74,
-					0x68, 0x61, 0x74, 0x27, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65,
-					0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
-					0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x43, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x29, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x6f, 0x73, 0x74,
-					0x6e, 0x61, 0x6d, 0x65, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e,
-					0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-					0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x74,
-					0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e,
-					0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a,
-					0x09, 0x76, 0x61, 0x72, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72,
-					0x6f, 0x72, 0x0a, 0x09, 0x68, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x6f,
-					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x68, 0x6e, 0x20, 0x3d, 0x3d,
-					0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65,
-					0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x21, 0x3d,
-					0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x68, 0x6e, 0x20,
-					0x3d, 0x20, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x65, 0x6e, 0x76, 0x28,
-					0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x0a,
-					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x68, 0x6e, 0x20,
-					0x3d, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x69,
-					0x66, 0x20, 0x68, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20,
-					0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28,
-					0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
-					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
-					0x72, 0x6e, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09,
-					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
-					0x69, 0x66, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
-					0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
-					0x48, 0x6f, 0x73, 0x74, 0x73, 0x5b, 0x68, 0x6e, 0x5d, 0x3b, 0x20, 0x21,
-					0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x2f,
-					0x2f, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x68,
-					0x6f, 0x73, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x64, 0x6f, 0x65,
-					0x73, 0x6e, 0x27, 0x74, 0x20, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x20,
-					0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x73,
-					0x20, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x73, 0x65,
-					0x65, 0x20, 0x69, 0x66, 0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x68, 0x6f,
-					0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x75,
-					0x6c, 0x6c, 0x79, 0x20, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
-					0x64, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x65, 0x65, 0x20, 0x69,
-					0x66, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x27, 0x73, 0x20, 0x61, 0x20,
-					0x46, 0x51, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x0a, 0x09,
-					0x09, 0x2f, 0x2f, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
-					0x6f, 0x73, 0x74, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x0a, 0x09, 0x09,
-					0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49,
-					0x6e, 0x64, 0x65, 0x78, 0x28, 0x68, 0x6e, 0x2c, 0x22, 0x2e, 0x22, 0x29,
-					0x20, 0x3d, 0x3d, 0x20, 0x2d, 0x31, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
-					0x2f, 0x2f, 0x20, 0x6e, 0x6f, 0x20, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x20,
-					0x77, 0x61, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x6f, 0x20, 0x74, 0x68,
-					0x69, 0x73, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68,
-					0x61, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x72, 0x61, 0x77, 0x6c, 0x20,
-					0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x20, 0x74, 0x68, 0x65, 0x6d,
-					0x20, 0x61, 0x6c, 0x6c, 0x0a, 0x09, 0x09, 0x09, 0x71, 0x75, 0x61, 0x6c,
-					0x68, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x6e, 0x20, 0x2b, 0x20, 0x22,
-					0x2e, 0x22, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x6b, 0x20,
-					0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e,
-					0x66, 0x69, 0x67, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x7b,
-					0x0a, 0x09, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69,
-					0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69,
-					0x78, 0x28, 0x6b, 0x2c, 0x20, 0x71, 0x75, 0x61, 0x6c, 0x68, 0x6e, 0x29,
-					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
-					0x72, 0x6e, 0x20, 0x6b, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09,
-					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d,
-					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
-					0x68, 0x6e, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x64, 0x78, 0x2c, 0x20, 0x73,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+					0x6f, 0x72, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x21,
+					0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x6c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x63,
+					0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x09, 0x69, 0x66, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x78, 0x69, 0x73,
+					0x74, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b,
+					0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5d, 0x3b,
+					0x20, 0x21, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x73,
+					0x2c, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66,
+					0x28, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+					0x20, 0x25, 0x64, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+					0x64, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73,
+					0x65, 0x74, 0x20, 0x25, 0x73, 0x20, 0x62, 0x75, 0x74, 0x20, 0x74, 0x68,
+					0x61, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x65,
+					0x78, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x20, 0x69, 0x64, 0x78, 0x2c, 0x20,
+					0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x73, 0x2e, 0x53, 0x65,
+					0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70,
+					0x70, 0x65, 0x6e, 0x64, 0x28, 0x72, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c,
+					0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2c, 0x20, 0x73, 0x29, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65,
+					0x73, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69,
+					0x6e, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x6f, 0x6b,
+					0x75, 0x70, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x65, 0x20,
+					0x69, 0x66, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x27,
+					0x73, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x77, 0x65, 0x20, 0x73, 0x68, 0x6f,
+					0x75, 0x6c, 0x64, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x0a, 0x2f, 0x2f,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x73, 0x20, 0x64, 0x65,
+					0x72, 0x76, 0x69, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x5b,
+					0x69, 0x6e, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5d, 0x0a, 0x2f, 0x2f,
+					0x20, 0x20, 0x20, 0x20, 0x31, 0x29, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+					0x72, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a,
+					0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x32, 0x29, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x65, 0x6d, 0x6e,
+					0x74, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x69,
+					0x6e, 0x20, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+					0x2c, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x22, 0x22, 0x0a,
+					0x2f, 0x2f, 0x20, 0x20, 0x20, 0x20, 0x33, 0x29, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x4f, 0x53, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+					0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x2f, 0x2f,
+					0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x70, 0x70,
+					0x6c, 0x69, 0x65, 0x64, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x65, 0x78,
+					0x69, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e,
+					0x6f, 0x74, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x71,
+					0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6e, 0x61, 0x6d,
+					0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65,
+					0x72, 0x65, 0x27, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x72,
+					0x79, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x74,
+					0x68, 0x61, 0x74, 0x20, 0x69, 0x73, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x79,
+					0x20, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2c, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x27, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x2a, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x29, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x6f, 0x73,
+					0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79,
+					0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b,
+					0x0a, 0x09, 0x76, 0x61, 0x72, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x0a, 0x09, 0x68, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x68, 0x6e, 0x20, 0x3d,
+					0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20,
+					0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x21,
+					0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x68, 0x6e,
+					0x20, 0x3d, 0x20, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x65, 0x6e, 0x76,
+					0x28, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x29,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x68, 0x6e,
+					0x20, 0x3d, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
+					0x69, 0x66, 0x20, 0x68, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d,
+					0x20, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x28, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a,
+					0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74,
+					0x73, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+					0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x5b, 0x68, 0x6e, 0x5d, 0x3b, 0x20,
+					0x21, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x2f, 0x2f, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x64, 0x6f,
+					0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72,
+					0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f, 0x73, 0x74,
+					0x73, 0x20, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x73,
+					0x65, 0x65, 0x20, 0x69, 0x66, 0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66,
+					0x75, 0x6c, 0x6c, 0x79, 0x20, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69,
+					0x65, 0x64, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x65, 0x65, 0x20,
+					0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x27, 0x73, 0x20, 0x61,
+					0x20, 0x46, 0x51, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x0a,
+					0x09, 0x09, 0x2f, 0x2f, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x68, 0x6f, 0x73, 0x74, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x0a, 0x09,
+					0x09, 0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e,
+					0x49, 0x6e, 0x64, 0x65, 0x78, 0x28, 0x68, 0x6e, 0x2c, 0x22, 0x2e, 0x22,
+					0x29, 0x20, 0x3d, 0x3d, 0x20, 0x2d, 0x31, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x2f, 0x2f, 0x20, 0x6e, 0x6f, 0x20, 0x71, 0x75, 0x69, 0x63, 0x6b,
+					0x20, 0x77, 0x61, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x6f, 0x20, 0x74,
+					0x68, 0x69, 0x73, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74,
+					0x68, 0x61, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x72, 0x61, 0x77, 0x6c,
+					0x20, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x20, 0x74, 0x68, 0x65,
+					0x6d, 0x20, 0x61, 0x6c, 0x6c, 0x0a, 0x09, 0x09, 0x09, 0x71, 0x75, 0x61,
+					0x6c, 0x68, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x6e, 0x20, 0x2b, 0x20,
+					0x22, 0x2e, 0x22, 0x0a, 0x09, 0x09, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x6b,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x50, 0x72, 0x65, 0x66,
+					0x69, 0x78, 0x28, 0x6b, 0x2c, 0x20, 0x71, 0x75, 0x61, 0x6c, 0x68, 0x6e,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x6b, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09,
+					0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x68, 0x6e, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "config.go.template",
-					size:    8518,
-					modTime: time.Unix(0, 1619806641339561998),
+					size:    42119,
+					modTime: time.Unix(0, 1792432532418980317),
 					isDir:   false,
 				},
 			}, "/config_test.go.template": {
@@ -868,177 +4638,278 @@ func init() {
 					0x6f, 0x75, 0x74, 0x69, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x22, 0x6f, 0x73,
 					0x22, 0x0a, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
 					0x22, 0x0a, 0x20, 0x20, 0x22, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-					0x22, 0x0a, 0x20, 0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x0a, 0x0a,
-					0x20, 0x20, 0x2f, 0x2f, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-					0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x74, 0x63, 0x68, 0x72,
-					0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x66, 0x79, 0x2f, 0x61, 0x73, 0x73,
-					0x65, 0x72, 0x74, 0x22, 0x0a, 0x20, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68,
-					0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x74,
-					0x63, 0x68, 0x72, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x66, 0x79, 0x2f,
-					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x22, 0x0a, 0x29, 0x0a, 0x0a,
-					0x76, 0x61, 0x72, 0x20, 0x28, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x61,
-					0x6c, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x20, 0x3d, 0x20, 0x66, 0x61, 0x6c,
-					0x73, 0x65, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x72, 0x75, 0x65, 0x56,
-					0x61, 0x6c, 0x20, 0x3d, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x29, 0x0a,
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x44, 0x75,
-					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e,
-					0x67, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-					0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x20, 0x3a, 0x3d, 0x20,
-					0x66, 0x75, 0x6e, 0x63, 0x28, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-					0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x78,
-					0x70, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x20, 0x3a, 0x3d, 0x20,
-					0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x64, 0x29, 0x2e,
-					0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x69,
-					0x66, 0x20, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x20, 0x21, 0x3d, 0x20,
-					0x65, 0x78, 0x70, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x74, 0x2e, 0x45,
-					0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x53, 0x74, 0x72, 0x69, 0x6e,
-					0x67, 0x28, 0x29, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x64, 0x75, 0x72, 0x61,
-					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x25, 0x64, 0x20, 0x65, 0x78, 0x70, 0x65,
-					0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x75,
-					0x72, 0x6e, 0x20, 0x25, 0x73, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x67,
-					0x6f, 0x74, 0x20, 0x25, 0x73, 0x22, 0x2c, 0x20, 0x64, 0x2c, 0x20, 0x65,
-					0x78, 0x70, 0x2c, 0x20, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x29, 0x0a,
-					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69,
-					0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x2c, 0x20, 0x22,
-					0x31, 0x73, 0x22, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69, 0x6d, 0x65,
-					0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x2a, 0x33, 0x30, 0x2c, 0x20,
-					0x22, 0x33, 0x30, 0x73, 0x22, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69,
-					0x6d, 0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x2c, 0x20, 0x22,
-					0x31, 0x6d, 0x30, 0x73, 0x22, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69,
-					0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x2a, 0x39, 0x30,
-					0x2c, 0x20, 0x22, 0x31, 0x6d, 0x33, 0x30, 0x73, 0x22, 0x29, 0x0a, 0x09,
-					0x66, 0x28, 0x30, 0x2c, 0x20, 0x22, 0x30, 0x73, 0x22, 0x29, 0x0a, 0x7d,
-					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x44,
-					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x4a, 0x53, 0x4f, 0x4e,
-					0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-					0x54, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x66,
-					0x75, 0x6e, 0x63, 0x28, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44,
-					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x78, 0x70,
-					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09,
-					0x09, 0x76, 0x20, 0x3a, 0x3d, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-					0x6f, 0x6e, 0x28, 0x64, 0x29, 0x0a, 0x09, 0x09, 0x62, 0x79, 0x74, 0x65,
-					0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73,
-					0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x26,
-					0x76, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
-					0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
-					0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e,
-					0x61, 0x62, 0x6c, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
-					0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x20, 0x6f, 0x75, 0x72,
-					0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
-					0x20, 0x25, 0x2b, 0x76, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x76,
-					0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09,
-					0x09, 0x69, 0x66, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62,
-					0x79, 0x74, 0x65, 0x73, 0x29, 0x20, 0x21, 0x3d, 0x20, 0x65, 0x78, 0x70,
-					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-					0x72, 0x66, 0x28, 0x22, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65,
-					0x64, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65,
-					0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x67,
-					0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x20, 0x25, 0x76, 0x2c, 0x20,
-					0x62, 0x75, 0x74, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x76, 0x22, 0x2c,
-					0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-					0x28, 0x62, 0x79, 0x74, 0x65, 0x73, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x7d,
-					0x0a, 0x09, 0x09, 0x76, 0x61, 0x72, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64,
-					0x65, 0x64, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a,
-					0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
-					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68,
-					0x61, 0x6c, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2c, 0x20, 0x26, 0x64,
-					0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72,
-					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
-					0x09, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x47,
-					0x6f, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x74, 0x72, 0x79,
-					0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x6e, 0x6d, 0x61, 0x72,
-					0x73, 0x68, 0x61, 0x6c, 0x20, 0x25, 0x76, 0x20, 0x74, 0x6f, 0x20, 0x61,
-					0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x25,
-					0x76, 0x22, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62,
-					0x79, 0x74, 0x65, 0x73, 0x29, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
-					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x64, 0x65, 0x63,
-					0x6f, 0x64, 0x65, 0x64, 0x20, 0x21, 0x3d, 0x20, 0x76, 0x20, 0x7b, 0x0a,
+					0x22, 0x0a, 0x20, 0x20, 0x22, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+					0x2f, 0x66, 0x73, 0x74, 0x65, 0x73, 0x74, 0x22, 0x0a, 0x20, 0x20, 0x22,
+					0x74, 0x69, 0x6d, 0x65, 0x22, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6d, 0x70, 0x6f,
+					0x72, 0x74, 0x73, 0x20, 0x22, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+					0x67, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x20, 0x22, 0x69, 0x6f, 0x2f,
+					0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x22, 0x20, 0x22, 0x6f, 0x73, 0x22,
+					0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x20, 0x22,
+					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x22, 0x74, 0x65,
+					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x66, 0x73, 0x74, 0x65, 0x73, 0x74,
+					0x22, 0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x20, 0x20, 0x2f, 0x2f, 0x22, 0x67, 0x69,
+					0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72,
+					0x65, 0x74, 0x63, 0x68, 0x72, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x66,
+					0x79, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x22, 0x0a, 0x20, 0x20,
+					0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+					0x73, 0x74, 0x72, 0x65, 0x74, 0x63, 0x68, 0x72, 0x2f, 0x74, 0x65, 0x73,
+					0x74, 0x69, 0x66, 0x79, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x28, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x20,
+					0x3d, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x74, 0x72, 0x75, 0x65, 0x56, 0x61, 0x6c, 0x20, 0x3d, 0x20, 0x74, 0x72,
+					0x75, 0x65, 0x0a, 0x29, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x79,
+					0x70, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x2f, 0x2f, 0x20, 0x65, 0x6e, 0x73,
+					0x75, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x63, 0x6b,
+					0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65,
+					0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x74, 0x79, 0x70, 0x65,
+					0x20, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x69,
+					0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x5f,
+					0x20, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x54, 0x65, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x74, 0x20, 0x2a, 0x74,
+					0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a,
+					0x09, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x64,
+					0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x61, 0x63, 0x74, 0x75,
+					0x61, 0x6c, 0x20, 0x3a, 0x3d, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x28, 0x64, 0x29, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x28, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x61, 0x63, 0x74, 0x75,
+					0x61, 0x6c, 0x20, 0x21, 0x3d, 0x20, 0x65, 0x78, 0x70, 0x20, 0x7b, 0x0a,
 					0x09, 0x09, 0x09, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28,
-					0x22, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x2f, 0x44, 0x65, 0x63,
-					0x6f, 0x64, 0x65, 0x64, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20,
-					0x65, 0x71, 0x75, 0x61, 0x6c, 0x21, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67,
-					0x69, 0x6e, 0x61, 0x6c, 0x20, 0x25, 0x76, 0x2c, 0x20, 0x72, 0x6f, 0x75,
-					0x6e, 0x64, 0x2d, 0x74, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x20, 0x25,
-					0x76, 0x22, 0x2c, 0x20, 0x76, 0x2c, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64,
-					0x65, 0x64, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
-					0x66, 0x28, 0x30, 0x2c, 0x20, 0x60, 0x22, 0x30, 0x73, 0x22, 0x60, 0x29,
+					0x22, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x25,
+					0x64, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74,
+					0x6f, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x25, 0x73, 0x2c,
+					0x20, 0x62, 0x75, 0x74, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x73, 0x22,
+					0x2c, 0x20, 0x64, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x61, 0x63,
+					0x74, 0x75, 0x61, 0x6c, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d,
 					0x0a, 0x09, 0x66, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63,
-					0x6f, 0x6e, 0x64, 0x2c, 0x20, 0x60, 0x22, 0x31, 0x73, 0x22, 0x60, 0x29,
+					0x6f, 0x6e, 0x64, 0x2c, 0x20, 0x22, 0x31, 0x73, 0x22, 0x29, 0x0a, 0x09,
+					0x66, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+					0x64, 0x2a, 0x33, 0x30, 0x2c, 0x20, 0x22, 0x33, 0x30, 0x73, 0x22, 0x29,
 					0x0a, 0x09, 0x66, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x69, 0x6e,
-					0x75, 0x74, 0x65, 0x2a, 0x35, 0x2c, 0x20, 0x60, 0x22, 0x35, 0x6d, 0x30,
+					0x75, 0x74, 0x65, 0x2c, 0x20, 0x22, 0x31, 0x6d, 0x30, 0x73, 0x22, 0x29,
+					0x0a, 0x09, 0x66, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63,
+					0x6f, 0x6e, 0x64, 0x2a, 0x39, 0x30, 0x2c, 0x20, 0x22, 0x31, 0x6d, 0x33,
+					0x30, 0x73, 0x22, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x30, 0x2c, 0x20, 0x22,
+					0x30, 0x73, 0x22, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x54, 0x65, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65,
+					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x66, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x64, 0x20,
+					0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x76, 0x20, 0x3a, 0x3d, 0x20,
+					0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x64, 0x29, 0x0a,
+					0x09, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72,
+					0x73, 0x68, 0x61, 0x6c, 0x28, 0x26, 0x76, 0x29, 0x0a, 0x09, 0x09, 0x69,
+					0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61,
+					0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x74,
+					0x6f, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68,
+					0x61, 0x6c, 0x20, 0x6f, 0x75, 0x72, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x25, 0x2b, 0x76, 0x3a, 0x20,
+					0x25, 0x76, 0x22, 0x2c, 0x20, 0x76, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73, 0x29, 0x20,
+					0x21, 0x3d, 0x20, 0x65, 0x78, 0x70, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
+					0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x4d, 0x61,
+					0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x64, 0x20, 0x64, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+					0x64, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+					0x65, 0x20, 0x25, 0x76, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x67, 0x6f,
+					0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x2c, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73,
+					0x29, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x76, 0x61, 0x72,
+					0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x44, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x55,
+					0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x62, 0x79, 0x74,
+					0x65, 0x73, 0x2c, 0x20, 0x26, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
+					0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
+					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x74, 0x2e, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x66, 0x28, 0x22, 0x47, 0x6f, 0x74, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x20, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f,
+					0x20, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x20, 0x25,
+					0x76, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73, 0x29, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
+					0x69, 0x66, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x21,
+					0x3d, 0x20, 0x76, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x74, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x64, 0x2f, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x64,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x6c,
+					0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x21,
+					0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x20, 0x25,
+					0x76, 0x2c, 0x20, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2d, 0x74, 0x72, 0x69,
+					0x70, 0x70, 0x65, 0x64, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x76, 0x2c,
+					0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x0a, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x66, 0x28, 0x30, 0x2c, 0x20, 0x60,
+					0x22, 0x30, 0x73, 0x22, 0x60, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69,
+					0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x2c, 0x20, 0x60,
+					0x22, 0x31, 0x73, 0x22, 0x60, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69,
+					0x6d, 0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x2a, 0x35, 0x2c,
+					0x20, 0x60, 0x22, 0x35, 0x6d, 0x30, 0x73, 0x22, 0x60, 0x29, 0x0a, 0x09,
+					0x66, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+					0x64, 0x2a, 0x39, 0x30, 0x2c, 0x20, 0x60, 0x22, 0x31, 0x6d, 0x33, 0x30,
 					0x73, 0x22, 0x60, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69, 0x6d, 0x65,
-					0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x2a, 0x39, 0x30, 0x2c, 0x20,
-					0x60, 0x22, 0x31, 0x6d, 0x33, 0x30, 0x73, 0x22, 0x60, 0x29, 0x0a, 0x09,
-					0x66, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x48, 0x6f, 0x75, 0x72, 0x2a,
-					0x32, 0x2c, 0x20, 0x60, 0x22, 0x32, 0x68, 0x30, 0x6d, 0x30, 0x73, 0x22,
-					0x60, 0x29, 0x0a, 0x09, 0x66, 0x28, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d,
-					0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x2a, 0x31,
-					0x30, 0x2c, 0x20, 0x60, 0x22, 0x31, 0x30, 0x6d, 0x73, 0x22, 0x60, 0x29,
-					0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73,
-					0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x4a, 0x53,
-					0x4f, 0x4e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x74, 0x20, 0x2a,
-					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75,
-					0x6e, 0x63, 0x28, 0x6a, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c,
-					0x20, 0x65, 0x78, 0x70, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75,
-					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20, 0x61, 0x63,
-					0x74, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
-					0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72,
-					0x73, 0x68, 0x61, 0x6c, 0x28, 0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x28,
-					0x6a, 0x29, 0x2c, 0x20, 0x26, 0x61, 0x63, 0x74, 0x29, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
-					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e,
-					0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e, 0x61, 0x62,
-					0x6c, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x55,
-					0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x20, 0x25, 0x73, 0x3a,
-					0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x6a, 0x2c, 0x20, 0x65, 0x72, 0x72,
-					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x61,
-					0x63, 0x74, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x28, 0x29, 0x20, 0x21, 0x3d, 0x20, 0x65, 0x78, 0x70,
-					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28,
-					0x22, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6a,
-					0x73, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x25, 0x73, 0x20, 0x74, 0x6f,
-					0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-					0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x25, 0x73, 0x2c,
-					0x20, 0x62, 0x75, 0x74, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x73, 0x22,
-					0x2c, 0x20, 0x6a, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x61, 0x63,
-					0x74, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66,
-					0x28, 0x60, 0x22, 0x35, 0x6d, 0x22, 0x60, 0x2c, 0x20, 0x74, 0x69, 0x6d,
-					0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x20, 0x2a, 0x35, 0x29,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x28, 0x60, 0x31, 0x32, 0x30, 0x60,
-					0x2c, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-					0x64, 0x20, 0x2a, 0x20, 0x31, 0x32, 0x30, 0x29, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x66, 0x28, 0x60, 0x30, 0x60, 0x2c, 0x20, 0x30, 0x29, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x66, 0x28, 0x60, 0x22, 0x31, 0x6d, 0x35, 0x73, 0x22,
-					0x60, 0x2c, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f,
-					0x6e, 0x64, 0x20, 0x2a, 0x20, 0x36, 0x35, 0x29, 0x0a, 0x7d, 0x0a, 0x0a,
-					0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x74, 0x20, 0x3a,
-					0x3d, 0x20, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
-					0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74,
-					0x5f, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d, 0x7d, 0x28, 0x74, 0x20, 0x2a,
-					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b,
-					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x78, 0x61,
-					0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30,
-					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20, 0x7a,
-					0x65, 0x72, 0x6f, 0x20, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x4f,
-					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x20,
-					0x7d, 0x7d, 0x28, 0x26, 0x64, 0x2c, 0x20, 0x26, 0x7a, 0x65, 0x72, 0x6f,
+					0x2e, 0x48, 0x6f, 0x75, 0x72, 0x2a, 0x32, 0x2c, 0x20, 0x60, 0x22, 0x32,
+					0x68, 0x30, 0x6d, 0x30, 0x73, 0x22, 0x60, 0x29, 0x0a, 0x09, 0x66, 0x28,
+					0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
+					0x63, 0x6f, 0x6e, 0x64, 0x2a, 0x31, 0x30, 0x2c, 0x20, 0x60, 0x22, 0x31,
+					0x30, 0x6d, 0x73, 0x22, 0x60, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x44, 0x65, 0x63, 0x6f,
+					0x64, 0x65, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+					0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66,
+					0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6a, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x20, 0x74,
+					0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x76, 0x61, 0x72, 0x20, 0x61, 0x63, 0x74, 0x20, 0x44, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+					0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x5b,
+					0x5d, 0x62, 0x79, 0x74, 0x65, 0x28, 0x6a, 0x29, 0x2c, 0x20, 0x26, 0x61,
+					0x63, 0x74, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
+					0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66,
+					0x28, 0x22, 0x55, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x74, 0x6f, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68,
+					0x61, 0x6c, 0x20, 0x25, 0x73, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x6a, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x69, 0x66, 0x20, 0x61, 0x63, 0x74, 0x2e, 0x54, 0x69, 0x6d,
+					0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x29, 0x20,
+					0x21, 0x3d, 0x20, 0x65, 0x78, 0x70, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x45, 0x78, 0x70, 0x65, 0x63,
+					0x74, 0x69, 0x6e, 0x67, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+					0x20, 0x25, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75,
+					0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x25, 0x73, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x67,
+					0x6f, 0x74, 0x20, 0x25, 0x73, 0x22, 0x2c, 0x20, 0x6a, 0x2c, 0x20, 0x65,
+					0x78, 0x70, 0x2c, 0x20, 0x61, 0x63, 0x74, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x28, 0x60, 0x22, 0x35, 0x6d, 0x22,
+					0x60, 0x2c, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x75,
+					0x74, 0x65, 0x20, 0x2a, 0x35, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66,
+					0x28, 0x60, 0x31, 0x32, 0x30, 0x60, 0x2c, 0x20, 0x74, 0x69, 0x6d, 0x65,
+					0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x20, 0x2a, 0x20, 0x31, 0x32,
+					0x30, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x28, 0x60, 0x30, 0x60,
+					0x2c, 0x20, 0x30, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x28, 0x60,
+					0x22, 0x31, 0x6d, 0x35, 0x73, 0x22, 0x60, 0x2c, 0x20, 0x74, 0x69, 0x6d,
+					0x65, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x20, 0x2a, 0x20, 0x36,
+					0x35, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x42, 0x61, 0x73,
+					0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x7b, 0x7b, 0x24, 0x74, 0x2e,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6e, 0x63,
+					0x7d, 0x7d, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+					0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x64,
+					0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61,
+					0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x76, 0x61, 0x72, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x7b, 0x7b,
+					0x24, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x09, 0x7b,
+					0x7b, 0x20, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x7d, 0x7d, 0x28, 0x26, 0x64, 0x2c,
+					0x20, 0x26, 0x7a, 0x65, 0x72, 0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x45,
+					0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x64, 0x2c, 0x20, 0x7a,
+					0x65, 0x72, 0x6f, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d,
+					0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x6e, 0x27, 0x74, 0x20,
+					0x68, 0x61, 0x76, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x7a, 0x65, 0x72, 0x6f,
+					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x20, 0x6e, 0x6f, 0x77, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x64,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x20, 0x3a, 0x3d, 0x20, 0x7b,
+					0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
+					0x31, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x24,
+					0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x75,
+					0x6e, 0x63, 0x20, 0x7d, 0x7d, 0x28, 0x26, 0x64, 0x2c, 0x20, 0x26, 0x6f,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x64,
+					0x2c, 0x20, 0x6f, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d,
+					0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x62, 0x75, 0x74, 0x20,
+					0x64, 0x69, 0x64, 0x6e, 0x27, 0x74, 0x2e, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x20, 0x25, 0x76, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+					0x69, 0x6e, 0x67, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x2c, 0x20,
+					0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x24, 0x74, 0x2e, 0x49, 0x73, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x7d, 0x7d,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65,
+					0x28, 0x74, 0x2c, 0x20, 0x26, 0x64, 0x5b, 0x30, 0x5d, 0x20, 0x21, 0x3d,
+					0x20, 0x26, 0x6f, 0x5b, 0x30, 0x5d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24,
+					0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x75,
+					0x6e, 0x63, 0x7d, 0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
+					0x63, 0x6f, 0x70, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c,
+					0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x69,
+					0x74, 0x22, 0x29, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x49,
+					0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74,
+					0x2c, 0x20, 0x64, 0x20, 0x21, 0x3d, 0x20, 0x6f, 0x2c, 0x20, 0x22, 0x7b,
+					0x7b, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x46, 0x75, 0x6e, 0x63, 0x7d, 0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c,
+					0x64, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75,
+					0x65, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65,
+					0x20, 0x69, 0x74, 0x22, 0x29, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74,
+					0x2e, 0x49, 0x73, 0x4d, 0x61, 0x70, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x66, 0x6f, 0x72, 0x20, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x64, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x28, 0x64,
+					0x2c, 0x20, 0x6b, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x74, 0x2c, 0x20, 0x6f,
+					0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d, 0x7d, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73,
+					0x68, 0x61, 0x72, 0x65, 0x20, 0x69, 0x74, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x6e, 0x2c, 0x20, 0x24, 0x74, 0x20,
+					0x3a, 0x3d, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x7d,
+					0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65,
+					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x20, 0x3a, 0x3d, 0x20, 0x7b,
+					0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f,
+					0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+					0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x64, 0x65, 0x73, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x6f,
+					0x72, 0x69, 0x67, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20,
+					0x7a, 0x65, 0x72, 0x6f, 0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x0a,
+					0x09, 0x64, 0x65, 0x73, 0x74, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x26, 0x7a, 0x65, 0x72, 0x6f,
 					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-					0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74,
-					0x2c, 0x20, 0x64, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x2c, 0x20, 0x22,
-					0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-					0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d, 0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x64,
+					0x65, 0x73, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2c, 0x20, 0x22,
+					0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x73, 0x68, 0x6f, 0x75,
 					0x6c, 0x64, 0x6e, 0x27, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6f,
 					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65,
 					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68,
@@ -1046,142 +4917,670 @@ func init() {
 					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
 					0x74, 0x2f, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
 					0x2e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6e, 0x6f, 0x77, 0x20,
-					0x25, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
-					0x6f, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78,
-					0x20, 0x24, 0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
-					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72,
-					0x72, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x7d, 0x7d, 0x28,
-					0x26, 0x64, 0x2c, 0x20, 0x26, 0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x6f, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69,
+					0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79,
+					0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61,
+					0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x0a, 0x09, 0x64, 0x65,
+					0x73, 0x74, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46,
+					0x72, 0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
 					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61,
-					0x6c, 0x28, 0x74, 0x2c, 0x20, 0x64, 0x2c, 0x20, 0x6f, 0x2c, 0x20, 0x22,
-					0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-					0x65, 0x46, 0x75, 0x6e, 0x63, 0x7d, 0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75,
-					0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75,
-					0x65, 0x20, 0x62, 0x75, 0x74, 0x20, 0x64, 0x69, 0x64, 0x6e, 0x27, 0x74,
-					0x2e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x25, 0x76, 0x2c, 0x20,
-					0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x25, 0x76,
-					0x22, 0x2c, 0x20, 0x64, 0x2c, 0x20, 0x6f, 0x29, 0x0a, 0x7d, 0x0a, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x72, 0x61,
-					0x6e, 0x67, 0x65, 0x20, 0x24, 0x6e, 0x2c, 0x20, 0x24, 0x74, 0x20, 0x3a,
-					0x3d, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x7d, 0x7d,
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b,
-					0x24, 0x6e, 0x7d, 0x7d, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-					0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73,
-					0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b,
+					0x6c, 0x28, 0x74, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x6f,
+					0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x20,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6e, 0x6f, 0x77, 0x20, 0x25, 0x23,
+					0x76, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67,
+					0x20, 0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x2c,
+					0x20, 0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x32, 0x20, 0x3a,
+					0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x33, 0x7d,
+					0x7d, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x2e, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x32,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x78, 0x70, 0x20, 0x3a, 0x3d,
+					0x20, 0x6f, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x69,
+					0x72, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x3a, 0x3d, 0x20,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x20, 0x3d, 0x20, 0x6f, 0x32, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72,
+					0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20,
+					0x64, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x22,
+					0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69,
+					0x65, 0x6c, 0x64, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x2e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6e, 0x6f, 0x77, 0x20,
+					0x25, 0x23, 0x76, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69,
+					0x6e, 0x67, 0x20, 0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x65, 0x73,
+					0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x5f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x74, 0x20, 0x2a,
+					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x20, 0x3a, 0x3d,
+					0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e,
+					0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70,
+					0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x6c, 0x20, 0x3a, 0x3d, 0x20, 0x6f,
+					0x72, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69,
+					0x67, 0x2c, 0x20, 0x2a, 0x63, 0x6c, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24,
+					0x6e, 0x7d, 0x7d, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x29, 0x20,
+					0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x76,
+					0x61, 0x6c, 0x75, 0x65, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d,
+					0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e,
+					0x49, 0x73, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74,
+					0x2c, 0x20, 0x26, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x20, 0x21,
+					0x3d, 0x20, 0x26, 0x63, 0x6c, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x2c, 0x20, 0x22, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28,
+					0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x70,
+					0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e, 0x47,
+					0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+					0x74, 0x65, 0x72, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72,
+					0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x20, 0x21, 0x3d, 0x20, 0x63, 0x6c, 0x2e, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28,
+					0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x70,
+					0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x24, 0x66,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x73, 0x4d, 0x61,
+					0x70, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6b, 0x20, 0x3a, 0x3d,
+					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6c, 0x2e, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x7b, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x28, 0x63,
+					0x6c, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x2c, 0x20, 0x6b, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79,
+					0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28,
+					0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x70,
+					0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x6d, 0x61, 0x70, 0x22, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20,
+					0x6e, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x2a, 0x7b, 0x7b,
+					0x24, 0x6e, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x69, 0x6c, 0x28, 0x74, 0x2c, 0x20,
+					0x6e, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x43, 0x6c, 0x6f,
+					0x6e, 0x65, 0x28, 0x29, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x24, 0x74, 0x2e, 0x48, 0x61, 0x73, 0x41, 0x6c, 0x69, 0x61,
+					0x73, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54,
+					0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x5f, 0x72, 0x65,
+					0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x28,
+					0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+					0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x78, 0x70, 0x20,
+					0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24,
+					0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61,
+					0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
+					0x31, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x64, 0x65, 0x63, 0x6f,
+					0x64, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x76,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72,
+					0x73, 0x68, 0x61, 0x6c, 0x28, 0x76, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x76, 0x61, 0x72, 0x20, 0x64, 0x6f, 0x63, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61,
+					0x6c, 0x28, 0x62, 0x2c, 0x20, 0x26, 0x64, 0x6f, 0x63, 0x29, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74, 0x2c, 0x20,
+					0x6e, 0x65, 0x77, 0x28, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x29, 0x2e,
+					0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
+					0x73, 0x28, 0x64, 0x6f, 0x63, 0x29, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65,
+					0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20,
+					0x25, 0x73, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65,
+					0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x22, 0x2c, 0x20, 0x62,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x62, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x64, 0x6f, 0x63, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20, 0x72, 0x65, 0x73,
+					0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61,
+					0x6c, 0x28, 0x62, 0x2c, 0x20, 0x26, 0x72, 0x65, 0x73, 0x29, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x72, 0x65, 0x73, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69,
+					0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x24, 0x61, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x66, 0x2e, 0x41,
+					0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x64,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+					0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x20, 0x7b, 0x7b, 0x70, 0x72, 0x69, 0x6e,
+					0x74, 0x66, 0x20, 0x22, 0x25, 0x71, 0x22, 0x20, 0x24, 0x61, 0x7d, 0x7d,
+					0x3a, 0x20, 0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x7d, 0x29, 0x2e, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x74,
+					0x68, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x20, 0x7b, 0x7b, 0x24,
+					0x61, 0x7d, 0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x73,
+					0x65, 0x74, 0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x22, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x70,
+					0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x2c, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x6d, 0x61, 0x70,
+					0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x20, 0x7b, 0x7b, 0x70,
+					0x72, 0x69, 0x6e, 0x74, 0x66, 0x20, 0x22, 0x25, 0x71, 0x22, 0x20, 0x24,
+					0x61, 0x7d, 0x7d, 0x3a, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20,
+					0x7b, 0x7b, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x20, 0x22, 0x25, 0x71,
+					0x22, 0x20, 0x24, 0x66, 0x2e, 0x4b, 0x65, 0x79, 0x7d, 0x7d, 0x3a, 0x20,
+					0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x20, 0x7d, 0x29, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65,
+					0x20, 0x6b, 0x65, 0x79, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4b, 0x65,
+					0x79, 0x7d, 0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62,
+					0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65,
+					0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x6c,
+					0x69, 0x61, 0x73, 0x20, 0x7b, 0x7b, 0x24, 0x61, 0x7d, 0x7d, 0x22, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x46, 0x61, 0x6c, 0x73,
+					0x65, 0x28, 0x74, 0x2c, 0x20, 0x6e, 0x65, 0x77, 0x28, 0x7b, 0x7b, 0x24,
+					0x6e, 0x7d, 0x7d, 0x29, 0x2e, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41,
+					0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x28, 0x5b, 0x5d, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x7d, 0x29, 0x29,
+					0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65,
+					0x63, 0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x7d, 0x7d, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24,
+					0x6e, 0x7d, 0x7d, 0x5f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74,
+					0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x76, 0x61, 0x72, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+					0x73, 0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x6e, 0x65, 0x77, 0x28, 0x7b, 0x7b, 0x24, 0x6e, 0x7d,
+					0x7d, 0x29, 0x2e, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x28, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e,
+					0x22, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x6b, 0x20, 0x3a, 0x3d,
+					0x20, 0x24, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+					0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x70, 0x72,
+					0x69, 0x6e, 0x74, 0x66, 0x20, 0x22, 0x25, 0x71, 0x22, 0x20, 0x24, 0x6b,
+					0x7d, 0x7d, 0x3a, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4e, 0x6f,
+					0x74, 0x41, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x20, 0x6e, 0x69,
+					0x6c, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x20, 0x66, 0x75,
+					0x6e, 0x63, 0x28, 0x77, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x77,
+					0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70,
+					0x70, 0x65, 0x6e, 0x64, 0x28, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+					0x73, 0x2c, 0x20, 0x77, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x4c, 0x65, 0x6e, 0x28, 0x74, 0x2c, 0x20, 0x77, 0x61, 0x72, 0x6e,
+					0x69, 0x6e, 0x67, 0x73, 0x2c, 0x20, 0x7b, 0x7b, 0x6c, 0x65, 0x6e, 0x20,
+					0x24, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+					0x64, 0x4b, 0x65, 0x79, 0x73, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x74, 0x68,
+					0x65, 0x72, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62,
+					0x65, 0x20, 0x61, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x20,
+					0x66, 0x6f, 0x72, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x64, 0x65, 0x70,
+					0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c,
+					0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x2c,
+					0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x77, 0x61,
+					0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x29, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24,
+					0x74, 0x2e, 0x48, 0x61, 0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d,
+					0x0a, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x46,
+					0x69, 0x78, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6c, 0x7d, 0x7d, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24,
+					0x6e, 0x7d, 0x7d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x53, 0x65, 0x74,
+					0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+					0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72,
+					0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x74, 0x2c, 0x20, 0x7a, 0x65,
+					0x72, 0x6f, 0x2e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x53, 0x65, 0x74, 0x28,
+					0x22, 0x22, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x61, 0x20, 0x3a,
+					0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x73, 0x65, 0x74, 0x20, 0x3a, 0x3d,
+					0x20, 0x61, 0x2e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x53, 0x65, 0x74, 0x28,
+					0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x22, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x74, 0x2c, 0x20, 0x73,
+					0x65, 0x74, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x20,
+					0x68, 0x61, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x74,
+					0x68, 0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46,
+					0x69, 0x78, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x28, 0x74, 0x2c,
+					0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d,
+					0x7d, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x22, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20,
+					0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x61, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x69, 0x78,
+					0x65, 0x64, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x74,
+					0x2c, 0x20, 0x61, 0x2e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x53, 0x65, 0x74,
+					0x28, 0x22, 0x22, 0x29, 0x2c, 0x20, 0x22, 0x63, 0x6c, 0x65, 0x61, 0x72,
+					0x46, 0x69, 0x78, 0x65, 0x64, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
+					0x20, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x74, 0x68,
+					0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x29,
+					0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x5f, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x20, 0x2a,
+					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x61, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b,
 					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54,
 					0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
 					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x64, 0x65, 0x73, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72,
-					0x69, 0x67, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20, 0x7a,
-					0x65, 0x72, 0x6f, 0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x0a, 0x09,
-					0x64, 0x65, 0x73, 0x74, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-					0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x26, 0x7a, 0x65, 0x72, 0x6f, 0x29,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x64, 0x65,
-					0x73, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2c, 0x20, 0x22, 0x7b,
-					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-					0x64, 0x6e, 0x27, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6f, 0x76,
-					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
-					0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65,
-					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x69, 0x73,
-					0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-					0x2f, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2e,
-					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6e, 0x6f, 0x77, 0x20, 0x25,
-					0x23, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x29, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x6f, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e,
+					0x20, 0x20, 0x62, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64,
+					0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65,
+					0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+					0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28,
+					0x74, 0x2c, 0x20, 0x61, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x26,
+					0x62, 0x29, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e,
+					0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x29, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x72,
+					0x75, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+					0x61, 0x6d, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69,
+					0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20,
+					0x3a, 0x3d, 0x20, 0x61, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x28, 0x7b, 0x7b, 0x69,
+					0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79,
+					0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61,
+					0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x29, 0x2e, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x46, 0x61,
+					0x6c, 0x73, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x61, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x63, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x29, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d,
+					0x7d, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x29, 0x20, 0x73, 0x68,
+					0x6f, 0x75, 0x6c, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x66, 0x61, 0x6c, 0x73, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x69,
+					0x73, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20, 0x6e, 0x69,
+					0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x2a, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x6e,
+					0x69, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61,
+					0x6c, 0x28, 0x6e, 0x69, 0x6c, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x46, 0x61, 0x6c, 0x73,
+					0x65, 0x28, 0x74, 0x2c, 0x20, 0x61, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x6e, 0x69, 0x6c, 0x29, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24, 0x6e, 0x7d,
+					0x7d, 0x5f, 0x44, 0x69, 0x66, 0x66, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65,
+					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x61, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e,
 					0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70,
 					0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-					0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x0a, 0x09, 0x64, 0x65, 0x73,
-					0x74, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x72,
-					0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72,
-					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
-					0x28, 0x74, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x6f, 0x2c,
-					0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x6f, 0x76, 0x65,
-					0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x73, 0x68,
-					0x6f, 0x75, 0x6c, 0x64, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6f, 0x76,
-					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
-					0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65,
-					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x20, 0x76,
-					0x61, 0x6c, 0x75, 0x65, 0x20, 0x6e, 0x6f, 0x77, 0x20, 0x25, 0x23, 0x76,
-					0x2c, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20,
-					0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x2c, 0x20,
-					0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x32, 0x20, 0x3a, 0x3d,
+					0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x62, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78,
+					0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45,
+					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+					0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x74,
+					0x2c, 0x20, 0x61, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x28, 0x26, 0x62, 0x29,
+					0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x44, 0x69,
+					0x66, 0x66, 0x28, 0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x63, 0x68,
+					0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x73, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x20, 0x3a, 0x3d,
 					0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e,
 					0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70,
-					0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x33, 0x7d, 0x7d,
-					0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72,
-					0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x32, 0x29,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x78, 0x70, 0x20, 0x3a, 0x3d, 0x20,
-					0x6f, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72,
-					0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x69,
-					0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-					0x64, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65,
-					0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74, 0x46,
-					0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20,
-					0x3d, 0x20, 0x6f, 0x32, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73,
-					0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-					0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x64,
-					0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x22, 0x7b,
-					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-					0x64, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
-					0x69, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65,
-					0x6c, 0x64, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74, 0x46,
-					0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e,
-					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6e, 0x6f, 0x77, 0x20, 0x25,
-					0x23, 0x76, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e,
-					0x67, 0x20, 0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74,
-					0x2c, 0x20, 0x65, 0x78, 0x70, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b,
-					0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x47, 0x65,
-					0x74, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
-					0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x5f, 0x47,
-					0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65,
-					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x20, 0x3a, 0x3d, 0x20, 0x7b,
-					0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f,
-					0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-					0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24,
-					0x69, 0x64, 0x78, 0x2c, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24,
-					0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20,
-					0x24, 0x66, 0x2e, 0x49, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x7d,
-					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d,
-					0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74,
-					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x43,
-					0x66, 0x67, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+					0x20, 0x3a, 0x3d, 0x20, 0x61, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x28, 0x26,
+					0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e,
+					0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x70, 0x20,
+					0x3a, 0x3d, 0x20, 0x24, 0x66, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61,
+					0x74, 0x68, 0x73, 0x7d, 0x7d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x68, 0x61, 0x73,
+					0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x28, 0x63, 0x68, 0x61, 0x6e, 0x67,
+					0x65, 0x73, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x70, 0x7d, 0x7d, 0x22,
+					0x29, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x44,
+					0x69, 0x66, 0x66, 0x28, 0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
+					0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x20, 0x61, 0x20, 0x63,
+					0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x7b, 0x7b, 0x24,
+					0x70, 0x7d, 0x7d, 0x2c, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x76, 0x22,
+					0x2c, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e, 0x53,
+					0x65, 0x63, 0x72, 0x65, 0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+					0x73, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x69, 0x66, 0x20, 0x63, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x20, 0x3d, 0x3d,
+					0x20, 0x22, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x22, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72,
-					0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-					0x7d, 0x7d, 0x2c, 0x20, 0x2a, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64,
-					0x78, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
-					0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x43, 0x66, 0x67, 0x28, 0x29, 0x20, 0x64, 0x6f, 0x65,
-					0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22,
-					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
-					0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e, 0x49,
-					0x73, 0x42, 0x6f, 0x6f, 0x6c, 0x50, 0x74, 0x72, 0x7d, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x67,
-					0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d, 0x20, 0x3a, 0x3d,
-					0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24,
-					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29, 0x0a, 0x20,
+					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x22, 0x2a,
+					0x2a, 0x2a, 0x2a, 0x22, 0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x6c, 0x64, 0x2c,
+					0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x44, 0x69, 0x66,
+					0x66, 0x28, 0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6d,
+					0x61, 0x73, 0x6b, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x63, 0x72,
+					0x65, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x22, 0x29, 0x0a, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
 					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
-					0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24,
-					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x26, 0x67,
-					0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d, 0x2c, 0x20, 0x22,
-					0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b,
-					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29, 0x20,
-					0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74,
-					0x63, 0x68, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x24,
-					0x66, 0x2e, 0x49, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x28, 0x74, 0x2c, 0x20, 0x22, 0x2a, 0x2a, 0x2a, 0x2a, 0x22, 0x2c, 0x20,
+					0x63, 0x2e, 0x4e, 0x65, 0x77, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x28, 0x29, 0x20, 0x73, 0x68,
+					0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x73, 0x6b, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x66, 0x69, 0x65,
+					0x6c, 0x64, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24,
+					0x6e, 0x7d, 0x7d, 0x5f, 0x50, 0x61, 0x74, 0x68, 0x73, 0x28, 0x74, 0x20,
+					0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x20, 0x3a,
+					0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x64, 0x65, 0x73, 0x74, 0x20, 0x3a,
+					0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+					0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x20, 0x3a, 0x3d, 0x20,
+					0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47,
+					0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x3a, 0x3d,
+					0x20, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x2e, 0x57, 0x61, 0x6c,
+					0x6b, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x70, 0x61, 0x74, 0x68, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e,
+					0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x63, 0x6f, 0x75,
+					0x6e, 0x74, 0x2b, 0x2b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x67, 0x6f, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x70,
+					0x61, 0x74, 0x68, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74,
+					0x2c, 0x20, 0x76, 0x2c, 0x20, 0x67, 0x6f, 0x74, 0x2c, 0x20, 0x22, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74,
+					0x68, 0x28, 0x25, 0x71, 0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x73, 0x61, 0x6d, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61,
+					0x73, 0x20, 0x57, 0x61, 0x6c, 0x6b, 0x22, 0x2c, 0x20, 0x70, 0x61, 0x74,
+					0x68, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x2e, 0x53,
+					0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x70, 0x61, 0x74, 0x68, 0x2c,
+					0x20, 0x70, 0x61, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x28, 0x74, 0x2c, 0x20, 0x76, 0x29, 0x29, 0x2c,
+					0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x53, 0x65, 0x74,
+					0x50, 0x61, 0x74, 0x68, 0x28, 0x25, 0x71, 0x29, 0x22, 0x2c, 0x20, 0x70,
+					0x61, 0x74, 0x68, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x4e, 0x6f, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x28, 0x74, 0x2c, 0x20, 0x63,
+					0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d,
+					0x7d, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x28, 0x29, 0x20, 0x73, 0x68, 0x6f,
+					0x75, 0x6c, 0x64, 0x20, 0x77, 0x61, 0x6c, 0x6b, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x2c, 0x20, 0x2a, 0x64,
+					0x65, 0x73, 0x74, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x29, 0x20, 0x66,
+					0x6f, 0x72, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77,
+					0x61, 0x6c, 0x6b, 0x65, 0x64, 0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x20,
+					0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c,
+					0x74, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66,
+					0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e, 0x49,
+					0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x61, 0x62, 0x6c, 0x65, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x65, 0x76, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61,
+					0x74, 0x68, 0x28, 0x22, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x5b, 0x30,
+					0x5d, 0x2c, 0x20, 0x65, 0x76, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e,
+					0x7d, 0x7d, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x29,
+					0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65,
+					0x20, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74,
+					0x2c, 0x20, 0x64, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61,
+					0x74, 0x68, 0x28, 0x22, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x22, 0x2c, 0x20, 0x70, 0x61, 0x74,
+					0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x76, 0x29, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f,
+					0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x2c, 0x20, 0x64, 0x65, 0x73, 0x74,
+					0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x5b, 0x30, 0x5d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x29, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x20, 0x65, 0x6c, 0x65, 0x6d,
+					0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6f,
+					0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28,
+					0x22, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x5b, 0x39, 0x39, 0x5d, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74,
+					0x50, 0x61, 0x74, 0x68, 0x28, 0x22, 0x4e, 0x6f, 0x74, 0x41, 0x46, 0x69,
+					0x65, 0x6c, 0x64, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x53, 0x65,
+					0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x22, 0x4e, 0x6f, 0x74, 0x41, 0x46,
+					0x69, 0x65, 0x6c, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x31, 0x22, 0x29, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69,
+					0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24,
+					0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x73, 0x4d,
+					0x61, 0x70, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47,
+					0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28, 0x22, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x6d, 0x69, 0x73, 0x73,
+					0x69, 0x6e, 0x67, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24,
+					0x6e, 0x7d, 0x7d, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x28,
+					0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x66, 0x61, 0x69,
+					0x6c, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x6b, 0x65, 0x79, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x69,
+					0x6e, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20,
+					0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+					0x28, 0x22, 0x7b, 0x7b, 0x28, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24,
+					0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x30, 0x29, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x57,
+					0x69, 0x74, 0x68, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24,
+					0x6e, 0x7d, 0x7d, 0x5f, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x28,
+					0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+					0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x72, 0x69, 0x67,
+					0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
+					0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x24, 0x69, 0x64, 0x78, 0x2c, 0x20, 0x24, 0x66,
+					0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e, 0x49, 0x73, 0x45, 0x6d,
+					0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72,
+					0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d, 0x20,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x20, 0x3d, 0x20, 0x26, 0x6f, 0x72, 0x69, 0x67, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x4e, 0x69, 0x6c, 0x28,
+					0x74, 0x2c, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d,
+					0x7d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+					0x65, 0x6e, 0x74, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66,
+					0x20, 0x24, 0x66, 0x2e, 0x49, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d,
+					0x7d, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65,
+					0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x43, 0x66, 0x67, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x26,
+					0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69,
+					0x64, 0x78, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d,
+					0x7d, 0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x43, 0x66, 0x67, 0x28, 0x29, 0x20, 0x64, 0x6f,
+					0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68,
+					0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e,
+					0x49, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53,
+					0x6c, 0x69, 0x63, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24,
+					0x69, 0x64, 0x78, 0x7d, 0x7d, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72, 0x69,
+					0x67, 0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x4c, 0x65, 0x6e, 0x28, 0x74, 0x2c, 0x20, 0x67, 0x76,
+					0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d, 0x2c, 0x20, 0x6c, 0x65,
+					0x6e, 0x28, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x2c, 0x20, 0x22, 0x7b, 0x7b,
+					0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29, 0x20, 0x64, 0x6f,
+					0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68,
+					0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69,
+					0x64, 0x78, 0x7d, 0x7d, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x26, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x5b, 0x69, 0x5d,
+					0x2c, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d,
+					0x5b, 0x69, 0x5d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x28, 0x29, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e,
+					0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65,
+					0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e, 0x49, 0x73,
+					0x42, 0x6f, 0x6f, 0x6c, 0x50, 0x74, 0x72, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x67, 0x76,
+					0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d, 0x20, 0x3a, 0x3d, 0x20,
+					0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28,
+					0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x26, 0x67, 0x76,
+					0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29, 0x20, 0x64,
+					0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63,
+					0x68, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x24, 0x66,
+					0x2e, 0x49, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d,
+					0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x74,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2e,
+					0x54, 0x69, 0x6d, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x28, 0x29, 0x2c, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78,
+					0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e,
+					0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x28, 0x29, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f,
+					0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65,
 					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d,
 					0x7d, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47, 0x65,
@@ -1190,321 +5589,2381 @@ func init() {
 					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45,
 					0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69, 0x67,
 					0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
-					0x2e, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x28, 0x29, 0x2c, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64,
-					0x78, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
-					0x2e, 0x47, 0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x28, 0x29, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e,
+					0x2c, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d, 0x7d,
+					0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x47, 0x65,
+					0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x43, 0x66, 0x67, 0x28, 0x29, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e,
 					0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x29, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6c, 0x73,
-					0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78,
-					0x7d, 0x7d, 0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x2e, 0x47,
-					0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
-					0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x69,
-					0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x2c, 0x20, 0x67, 0x76, 0x7b, 0x7b, 0x24, 0x69, 0x64, 0x78, 0x7d,
-					0x7d, 0x2c, 0x20, 0x22, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x2e, 0x47,
-					0x65, 0x74, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x43, 0x66, 0x67, 0x28, 0x29, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20,
-					0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x29, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e,
-					0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
-					0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a,
-					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
-					0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x4f,
-					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x28, 0x74, 0x20, 0x2a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x42,
+					0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x5f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x28, 0x74, 0x20, 0x2a,
 					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b,
-					0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x28, 0x69,
-					0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-					0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-					0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70,
-					0x65, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x78, 0x70, 0x20, 0x3a, 0x3d, 0x20,
+					0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47,
+					0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x62, 0x20, 0x3a, 0x3d, 0x20, 0x4e, 0x65, 0x77,
+					0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65,
+					0x72, 0x28, 0x29, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24,
+					0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+					0x64, 0x73, 0x7d, 0x7d, 0x2e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x28, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x65,
+					0x72, 0x41, 0x72, 0x67, 0x20, 0x22, 0x65, 0x78, 0x70, 0x22, 0x7d, 0x7d,
+					0x29, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x63, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x62,
+					0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x26, 0x65,
+					0x78, 0x70, 0x2c, 0x20, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x20, 0x76, 0x61, 0x6c,
+					0x75, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69,
+					0x65, 0x73, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
+					0x31, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x32, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x62, 0x2e, 0x7b, 0x7b, 0x28,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x73, 0x20, 0x30, 0x29, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x28, 0x7b, 0x7b, 0x28, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24,
+					0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x30, 0x29, 0x2e,
+					0x53, 0x65, 0x74, 0x74, 0x65, 0x72, 0x41, 0x72, 0x67, 0x20, 0x22, 0x6f,
+					0x74, 0x68, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x29, 0x2e, 0x42, 0x75, 0x69,
+					0x6c, 0x64, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x26, 0x65, 0x78, 0x70, 0x2c, 0x20,
+					0x63, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20,
+					0x6f, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x7b, 0x7b, 0x28, 0x69, 0x6e, 0x64,
+					0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+					0x20, 0x30, 0x29, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20,
+					0x63, 0x32, 0x2e, 0x7b, 0x7b, 0x28, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x30, 0x29,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x69, 0x66,
+					0x20, 0x24, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x74, 0x74,
+					0x65, 0x72, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65,
+					0x73, 0x74, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x5f, 0x53, 0x65, 0x74,
+					0x74, 0x65, 0x72, 0x73, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74,
+					0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x65, 0x78, 0x70, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70,
+					0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+					0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x76, 0x61, 0x72, 0x20, 0x63, 0x20, 0x7b, 0x7b, 0x24, 0x6e, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x20, 0x73, 0x20, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x53, 0x65, 0x74, 0x74, 0x65, 0x72, 0x20,
+					0x3d, 0x20, 0x26, 0x63, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24,
+					0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x24, 0x66, 0x2e, 0x49, 0x73, 0x45, 0x6d, 0x62, 0x65,
+					0x64, 0x64, 0x65, 0x64, 0x7d, 0x7d, 0x76, 0x61, 0x72, 0x20, 0x5f, 0x20,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x53, 0x65, 0x74, 0x74, 0x65, 0x72,
+					0x20, 0x3d, 0x20, 0x73, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x2e, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x3d,
+					0x20, 0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65,
+					0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x65, 0x72, 0x41, 0x72, 0x67,
+					0x20, 0x22, 0x65, 0x78, 0x70, 0x22, 0x7d, 0x7d, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74,
+					0x2c, 0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x63, 0x29, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x7b, 0x7b, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54,
+					0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x73, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73,
+					0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b,
+					0x20, 0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x28, 0x69, 0x6e, 0x64, 0x65,
+					0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20, 0x22,
 					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x44, 0x65, 0x66, 0x61,
-					0x75, 0x6c, 0x74, 0x73, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65,
-					0x78, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-					0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x2c, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x3a, 0x20,
-					0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73,
-					0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x22,
-					0x20, 0x3a, 0x20, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32,
-					0x22, 0x2c, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x32, 0x22, 0x3a, 0x22, 0x6d,
-					0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20,
+					0x6e, 0x22, 0x29, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x20, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+					0x73, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24,
+					0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+					0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x3a, 0x20, 0x6d, 0x61, 0x70,
+					0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x7b, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x20, 0x3a, 0x20,
+					0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x2c, 0x20,
+					0x22, 0x62, 0x6f, 0x62, 0x32, 0x22, 0x3a, 0x22, 0x6d, 0x69, 0x73, 0x73,
+					0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3a, 0x20, 0x6d,
+					0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x32, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70,
+					0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x32, 0x7d, 0x7d,
+					0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d,
+					0x0a, 0x20, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x20,
+					0x3a, 0x3d, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x5b, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32,
+					0x22, 0x5d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48,
+					0x61, 0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x63, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64,
+					0x28, 0x29, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+					0x32, 0x20, 0x3d, 0x20, 0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x64, 0x65, 0x6e, 0x28, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x32, 0x22, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x66, 0x2c, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d,
+					0x70, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61,
+					0x6c, 0x66, 0x28, 0x22, 0x55, 0x61, 0x6e, 0x62, 0x6c, 0x65, 0x20, 0x74,
+					0x6f, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x74, 0x65, 0x6d,
+					0x70, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x63, 0x6f,
+					0x64, 0x65, 0x72, 0x28, 0x66, 0x29, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x28, 0x26, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e, 0x43, 0x6c,
+					0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65,
+					0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e,
+					0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x73, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c,
+					0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20,
+					0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2c, 0x20, 0x62, 0x75, 0x74,
+					0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x2c, 0x20, 0x65, 0x78,
+					0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x25, 0x23, 0x76, 0x2c,
+					0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x63,
+					0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20, 0x2a,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x29, 0x0a, 0x20, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20,
+					0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28,
+					0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e,
+					0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32,
+					0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x22,
+					0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x68, 0x6f,
+					0x75, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x64, 0x65,
+					0x66, 0x61, 0x75, 0x6c, 0x74, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x64,
+					0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x65,
+					0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x25, 0x23, 0x76, 0x2c, 0x20, 0x67,
+					0x6f, 0x74, 0x20, 0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x78, 0x61,
+					0x6d, 0x70, 0x6c, 0x65, 0x32, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x62,
+					0x6f, 0x62, 0x32, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7c, 0x7c,
+					0x20, 0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29,
+					0x20, 0x21, 0x3d, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x68,
+					0x6f, 0x73, 0x74, 0x20, 0x62, 0x6f, 0x62, 0x32, 0x20, 0x73, 0x70, 0x65,
+					0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6d, 0x69, 0x73, 0x73,
+					0x69, 0x6e, 0x67, 0x20, 0x62, 0x75, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74,
+					0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x65, 0x78, 0x69,
+					0x73, 0x74, 0x22, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x53, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x67, 0x6f, 0x74, 0x74, 0x65, 0x6e,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74,
+					0x20, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x62,
+					0x75, 0x74, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x46, 0x6f,
+					0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x43, 0x6f, 0x70, 0x79,
+					0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+					0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a,
+					0x3d, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72,
+					0x75, 0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a,
+					0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e,
+					0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70,
+					0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d,
+					0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20,
 					0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-					0x5d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-					0x6f, 0x6e, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65,
-					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x20, 0x3a, 0x20, 0x7b,
-					0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x78,
+					0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x20, 0x22, 0x62, 0x6f,
+					0x62, 0x22, 0x20, 0x3a, 0x20, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x32, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x4f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3a, 0x20, 0x6d, 0x61,
+					0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70,
+					0x6c, 0x65, 0x32, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64,
+					0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65,
+					0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+					0x65, 0x73, 0x20, 0x32, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x63, 0x2e, 0x46, 0x6f, 0x72, 0x28, 0x22, 0x22, 0x2c, 0x20,
+					0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x61,
+					0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20, 0x2a, 0x64, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x73, 0x29, 0x0a, 0x20, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x64, 0x65, 0x6e, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x63, 0x2e, 0x46, 0x6f, 0x72, 0x28, 0x22, 0x22, 0x2c, 0x20,
+					0x22, 0x62, 0x6f, 0x62, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x6f,
+					0x76, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x32, 0x22, 0x5d, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20,
+					0x6f, 0x76, 0x2c, 0x20, 0x2a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x64, 0x65, 0x6e, 0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e,
+					0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x73, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66,
+					0x20, 0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49,
+					0x73, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75,
+					0x65, 0x28, 0x74, 0x2c, 0x20, 0x26, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x73, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x20, 0x21, 0x3d, 0x20, 0x26, 0x63, 0x2e,
+					0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x2c,
+					0x20, 0x22, 0x46, 0x6f, 0x72, 0x28, 0x29, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20,
+					0x73, 0x6c, 0x69, 0x63, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x26, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x2e, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d,
+					0x20, 0x21, 0x3d, 0x20, 0x26, 0x6f, 0x76, 0x2e, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x5b, 0x30, 0x5d, 0x2c, 0x20,
+					0x22, 0x46, 0x6f, 0x72, 0x28, 0x29, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c,
+					0x64, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x73,
+					0x6c, 0x69, 0x63, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x68, 0x61, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x72, 0x75, 0x65, 0x20,
+					0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x27, 0x73, 0x20, 0x61,
+					0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x61, 0x74, 0x20,
+					0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x20,
+					0x61, 0x6e, 0x79, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6e, 0x65,
+					0x73, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x20,
+					0x69, 0x74, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x68, 0x61, 0x73, 0x43,
+					0x68, 0x61, 0x6e, 0x67, 0x65, 0x28, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+					0x73, 0x20, 0x5b, 0x5d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2c, 0x20,
+					0x70, 0x61, 0x74, 0x68, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29,
+					0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x5f, 0x2c, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x63, 0x2e, 0x50,
+					0x61, 0x74, 0x68, 0x20, 0x3d, 0x3d, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20,
+					0x7c, 0x7c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x48,
+					0x61, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x28, 0x63, 0x2e, 0x50,
+					0x61, 0x74, 0x68, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68, 0x2b, 0x22, 0x2e,
+					0x22, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x73, 0x2e, 0x48, 0x61, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x28,
+					0x63, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x70, 0x61, 0x74, 0x68,
+					0x2b, 0x22, 0x5b, 0x22, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x72, 0x75,
+					0x65, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x7d, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x61, 0x6c,
+					0x73, 0x65, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x70, 0x61, 0x74,
+					0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x70,
+					0x61, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x53, 0x65, 0x74, 0x50, 0x61,
+					0x74, 0x68, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x76, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x70, 0x61, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65,
+					0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65,
+					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x2c, 0x20, 0x76, 0x20, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x73,
+					0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x74, 0x76, 0x20, 0x3a, 0x3d, 0x20,
+					0x76, 0x2e, 0x28, 0x74, 0x79, 0x70, 0x65, 0x29, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x63, 0x61, 0x73, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x3a, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x74, 0x76, 0x0a, 0x20, 0x20, 0x63, 0x61, 0x73, 0x65, 0x20, 0x44,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x76, 0x2e, 0x53,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a,
+					0x20, 0x20, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
+					0x28, 0x76, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x29,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73,
+					0x74, 0x5f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x28,
+					0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+					0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75,
+					0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a, 0x7b,
+					0x7b, 0x20, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x3a, 0x3d, 0x20,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x73, 0x20, 0x30, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63,
+					0x20, 0x3a, 0x3d, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x20, 0x7b, 0x7b,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x2c, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x3a, 0x20, 0x6d,
+					0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x7b, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x20,
+					0x3a, 0x20, 0x22, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x7d, 0x2c, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+					0x20, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+					0x70, 0x72, 0x6f, 0x64, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70,
+					0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+					0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x22, 0x65, 0x75, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x33, 0x7d, 0x7d, 0x2c, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x20,
+					0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x35, 0x7d,
+					0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x3a,
+					0x20, 0x5b, 0x5d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x20, 0x4d, 0x61, 0x74,
+					0x63, 0x68, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x65,
+					0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x7d,
+					0x2c, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x3a, 0x20,
+					0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x20, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x3a,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x65, 0x6e, 0x76, 0x22,
+					0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x72,
+					0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x65, 0x75, 0x22,
+					0x7d, 0x2c, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x3a,
+					0x20, 0x22, 0x65, 0x75, 0x22, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x66,
+					0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x2e,
+					0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x22, 0x22,
+					0x2c, 0x20, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x2c, 0x20, 0x6e,
+					0x69, 0x6c, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c,
+					0x20, 0x2a, 0x63, 0x66, 0x67, 0x2c, 0x20, 0x22, 0x46, 0x6f, 0x72, 0x4c,
+					0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x29, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x20, 0x6e, 0x6f, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+					0x73, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x73, 0x65, 0x6c, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x2e, 0x53, 0x65, 0x6c,
+					0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x6c,
+					0x69, 0x63, 0x65, 0x22, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b,
+					0x22, 0x65, 0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64,
+					0x22, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x5b, 0x5d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x7b,
+					0x63, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5b,
+					0x30, 0x5d, 0x7d, 0x2c, 0x20, 0x73, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x6c,
+					0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28,
+					0x74, 0x2c, 0x20, 0x73, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x63, 0x66, 0x67, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x63, 0x2e, 0x46, 0x6f, 0x72, 0x4c,
+					0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x61,
+					0x6c, 0x69, 0x63, 0x65, 0x22, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x7b, 0x22, 0x65, 0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f,
+					0x64, 0x22, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74,
+					0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x73, 0x5b, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x5d, 0x2c, 0x20, 0x2a,
+					0x63, 0x66, 0x67, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63, 0x66, 0x67, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x63, 0x2e, 0x46, 0x6f, 0x72,
+					0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22,
+					0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x5b,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x7b, 0x22, 0x65, 0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72,
+					0x6f, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+					0x22, 0x3a, 0x20, 0x22, 0x65, 0x75, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x6f,
+					0x6c, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x70, 0x69, 0x22, 0x7d, 0x29,
+					0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x29, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x70, 0x20, 0x3a, 0x3d, 0x20,
+					0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b,
+					0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x5d, 0x0a, 0x20, 0x20, 0x65, 0x78,
+					0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x63, 0x2e, 0x4f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x65, 0x75, 0x22,
+					0x5d, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x2a, 0x63, 0x66, 0x67, 0x2c, 0x20,
+					0x22, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x29,
+					0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x61, 0x70, 0x70, 0x6c,
+					0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20,
+					0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x20, 0x69, 0x6e,
+					0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20,
+					0x73, 0x65, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x63,
+					0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+					0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x22, 0x22, 0x2c,
+					0x20, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x5b,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x7b, 0x22, 0x65, 0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72,
+					0x6f, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+					0x22, 0x3a, 0x20, 0x22, 0x65, 0x75, 0x22, 0x7d, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x22, 0x68, 0x6f, 0x73, 0x74,
+					0x22, 0x2c, 0x20, 0x73, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x4c, 0x65, 0x6e, 0x28, 0x74, 0x2c, 0x20, 0x73, 0x65,
+					0x6c, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2c,
+					0x20, 0x32, 0x29, 0x0a, 0x20, 0x20, 0x63, 0x66, 0x67, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3d, 0x20, 0x63, 0x2e, 0x46, 0x6f, 0x72, 0x4c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x62, 0x6f,
+					0x62, 0x22, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x65,
+					0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x2c,
+					0x20, 0x22, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22,
+					0x65, 0x75, 0x22, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28,
+					0x74, 0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x73, 0x5b, 0x22, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x5d, 0x2e, 0x7b,
+					0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x2c, 0x20, 0x63, 0x66, 0x67, 0x2e, 0x7b, 0x7b, 0x24, 0x66,
+					0x69, 0x72, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c,
+					0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+					0x64, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20,
+					0x63, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x20,
+					0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x63, 0x2e, 0x53,
+					0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2c, 0x20, 0x53, 0x65,
+					0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x7b, 0x20, 0x4d, 0x61, 0x74, 0x63,
+					0x68, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x65, 0x6e,
+					0x76, 0x22, 0x3a, 0x20, 0x22, 0x64, 0x65, 0x76, 0x22, 0x7d, 0x2c, 0x20,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x3a, 0x20, 0x22, 0x6d,
+					0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x7d, 0x29, 0x0a, 0x20,
+					0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x63, 0x2e,
+					0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x28, 0x22, 0x22,
+					0x2c, 0x20, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x2c, 0x20, 0x6d,
+					0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x65, 0x6e, 0x76, 0x22, 0x3a, 0x20,
+					0x22, 0x64, 0x65, 0x76, 0x22, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c,
+					0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+					0x20, 0x32, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65,
+					0x74, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x75,
+					0x74, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e,
+					0x27, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x46, 0x61,
+					0x6c, 0x73, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x28, 0x26, 0x53, 0x65, 0x6c,
+					0x65, 0x63, 0x74, 0x6f, 0x72, 0x7b, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x7d, 0x29,
+					0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x6d, 0x61, 0x70,
+					0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x7b, 0x22, 0x65, 0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70,
+					0x72, 0x6f, 0x64, 0x22, 0x7d, 0x29, 0x2c, 0x20, 0x22, 0x61, 0x20, 0x73,
+					0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x20, 0x6e, 0x6f, 0x20, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x73, 0x68,
+					0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x20, 0x6d,
+					0x61, 0x74, 0x63, 0x68, 0x22, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x61, 0x62, 0x65,
+					0x6c, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x28, 0x74, 0x20,
+					0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x65, 0x6e,
+					0x76, 0x28, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x45, 0x4e, 0x5f,
+					0x54, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x52,
+					0x45, 0x47, 0x49, 0x4f, 0x4e, 0x22, 0x2c, 0x20, 0x22, 0x75, 0x73, 0x2d,
+					0x77, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x6f, 0x73, 0x2e,
+					0x53, 0x65, 0x74, 0x65, 0x6e, 0x76, 0x28, 0x22, 0x43, 0x4f, 0x4e, 0x46,
+					0x49, 0x47, 0x45, 0x4e, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x41,
+					0x42, 0x45, 0x4c, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x22, 0x2c, 0x20, 0x22,
+					0x61, 0x70, 0x69, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65,
+					0x72, 0x20, 0x6f, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x65, 0x6e,
+					0x76, 0x28, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x45, 0x4e, 0x5f,
+					0x54, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x52,
+					0x45, 0x47, 0x49, 0x4f, 0x4e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x64, 0x65,
+					0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x65, 0x74,
+					0x65, 0x6e, 0x76, 0x28, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x45,
+					0x4e, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c,
+					0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28,
+					0x74, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x72, 0x65,
+					0x67, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x73, 0x2d, 0x77,
+					0x65, 0x73, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x22,
+					0x3a, 0x20, 0x22, 0x61, 0x70, 0x69, 0x22, 0x7d, 0x2c, 0x20, 0x4c, 0x61,
+					0x62, 0x65, 0x6c, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x28,
+					0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x45, 0x4e, 0x5f, 0x54, 0x45,
+					0x53, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x22, 0x29, 0x29,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73,
+					0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73,
+					0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b,
+					0x20, 0x24, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78,
+					0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20, 0x22, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x22, 0x20, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x66, 0x69, 0x72,
+					0x73, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x30, 0x20,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x73, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78,
 					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
-					0x32, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
-					0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x66, 0x2c, 0x65, 0x72, 0x72, 0x20,
-					0x3a, 0x3d, 0x20, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65,
-					0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22,
-					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69,
-					0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
-					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x46, 0x61, 0x74,
-					0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x61, 0x6e, 0x62, 0x6c, 0x65, 0x20,
-					0x74, 0x6f, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x74, 0x65,
-					0x6d, 0x70, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x20, 0x25, 0x76, 0x22,
-					0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20,
-					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x63,
-					0x6f, 0x64, 0x65, 0x72, 0x28, 0x66, 0x29, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
-					0x64, 0x65, 0x28, 0x26, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e, 0x43,
-					0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x66,
-					0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-					0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x29, 0x0a, 0x20,
-					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72,
-					0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22,
-					0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
-					0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55,
-					0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20,
-					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c,
-					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20,
+					0x31, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x48, 0x6f, 0x73,
+					0x74, 0x73, 0x20, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x20,
+					0x22, 0x62, 0x6f, 0x62, 0x22, 0x20, 0x3a, 0x20, 0x22, 0x65, 0x78, 0x61,
+					0x6d, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3a,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x32, 0x7d, 0x7d, 0x2c, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x20,
+					0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x33, 0x7d,
+					0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x3a,
+					0x20, 0x5b, 0x5d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x20, 0x4d, 0x61, 0x74,
+					0x63, 0x68, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x65,
+					0x6e, 0x76, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x7d,
+					0x2c, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x3a, 0x20,
+					0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x65,
+					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x20, 0x3a, 0x3d, 0x20, 0x63,
+					0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x22,
+					0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x5d, 0x0a, 0x7b,
+					0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x46, 0x69,
+					0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x2e, 0x63, 0x6c,
+					0x65, 0x61, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x29, 0x0a, 0x20,
+					0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x20, 0x3d, 0x20,
+					0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e,
+					0x28, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x29,
+					0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x69,
+					0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x46, 0x69,
+					0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x6f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65,
+					0x77, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x66, 0x29, 0x2e,
+					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x26, 0x63, 0x29, 0x29, 0x0a,
+					0x20, 0x20, 0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a,
+					0x20, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52,
+					0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x28, 0x29, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20,
+					0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29, 0x29, 0x0a, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45,
+					0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x44, 0x65,
+					0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f,
+					0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20,
+					0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x28, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x32, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x29,
+					0x0a, 0x0a, 0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69,
+					0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68,
+					0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63,
+					0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
+					0x7b, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x22, 0x62, 0x6f,
+					0x62, 0x22, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7d, 0x29, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x61,
+					0x6d, 0x70, 0x6c, 0x65, 0x32, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73,
+					0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
+					0x20, 0x63, 0x6f, 0x6d, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+					0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x22, 0x29, 0x0a, 0x0a, 0x20,
+					0x20, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x65, 0x6e, 0x76, 0x28, 0x22,
+					0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x45, 0x4e, 0x5f, 0x54, 0x45, 0x53,
+					0x54, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45, 0x22, 0x2c,
+					0x20, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x55, 0x6e, 0x73,
+					0x65, 0x74, 0x65, 0x6e, 0x76, 0x28, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+					0x47, 0x45, 0x4e, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x53,
+					0x54, 0x4e, 0x41, 0x4d, 0x45, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20,
+					0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29,
+					0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e, 0x76, 0x4b, 0x65, 0x79,
+					0x28, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x45, 0x4e, 0x5f, 0x54,
+					0x45, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45,
+					0x22, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x28, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x29, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x2c, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7d, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20,
 					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61,
 					0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
 					0x6c, 0x74, 0x73, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x2c, 0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x63, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-					0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68,
-					0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2c, 0x20, 0x62, 0x75,
-					0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x2c, 0x20, 0x65,
-					0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x25, 0x23, 0x76,
-					0x2c, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x23, 0x76, 0x22, 0x2c, 0x20,
-					0x63, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20,
-					0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x29, 0x0a, 0x20, 0x20, 0x63,
+					0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+					0x61, 0x6d, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x65, 0x6e, 0x76, 0x20, 0x76, 0x61, 0x72, 0x20, 0x73, 0x68, 0x6f,
+					0x75, 0x6c, 0x64, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x20, 0x70, 0x72, 0x65,
+					0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x46, 0x75, 0x6e, 0x63, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63,
 					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d,
-					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-					0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x62, 0x6f, 0x62,
-					0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
-					0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55,
-					0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20,
-					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c,
-					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20,
-					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61,
-					0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
-					0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-					0x65, 0x32, 0x22, 0x5d, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-					0x67, 0x2c, 0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x63,
-					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-					0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x74, 0x63,
-					0x68, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2c, 0x20, 0x62,
-					0x75, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x2c, 0x20,
-					0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x25, 0x23,
-					0x76, 0x2c, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x23, 0x76, 0x22, 0x2c,
-					0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
-					0x5b, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x5d,
-					0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x29, 0x0a, 0x20,
-					0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f,
-					0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c,
-					0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x32, 0x22, 0x29,
-					0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x3d,
-					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x65, 0x72, 0x72, 0x2e,
-					0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x20, 0x21, 0x3d, 0x20, 0x22,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28,
+					0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+					0x61, 0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29,
+					0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+					0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x65, 0x6e, 0x76, 0x22,
+					0x3a, 0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x7d, 0x29, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61,
+					0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x65,
+					0x78, 0x70, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x28, 0x22, 0x70, 0x72, 0x6f, 0x64,
+					0x22, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x70, 0x20, 0x3a, 0x3d, 0x20, 0x63,
+					0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x0a, 0x20, 0x20,
+					0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x63, 0x2e,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x70,
+					0x72, 0x6f, 0x64, 0x22, 0x5d, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72,
+					0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b,
+					0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20,
+					0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x73, 0x68, 0x6f,
+					0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69,
+					0x65, 0x64, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x6c, 0x61, 0x79, 0x65,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78,
+					0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45,
+					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+					0x20, 0x33, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20,
+					0x2e, 0x48, 0x61, 0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c,
+					0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c,
+					0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+					0x65, 0x28, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x29, 0x2c, 0x20, 0x57, 0x69,
+					0x74, 0x68, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x28,
 					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62,
-					0x6f, 0x62, 0x32, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
-					0x64, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73,
-					0x65, 0x74, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x62,
-					0x75, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73,
-					0x6e, 0x27, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0x20, 0x7b,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-					0x66, 0x28, 0x22, 0x53, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6f, 0x66,
-					0x20, 0x67, 0x6f, 0x74, 0x74, 0x65, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f,
-					0x72, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x6d, 0x69, 0x73, 0x73,
-					0x69, 0x6e, 0x67, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
-					0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x67, 0x6f,
-					0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
-					0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
-					0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x69, 0x73,
-					0x73, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x74, 0x20, 0x2a,
-					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b,
-					0x0a, 0x20, 0x20, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
-					0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x46,
-					0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x6d, 0x69, 0x73,
-					0x73, 0x69, 0x6e, 0x67, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e, 0x43,
-					0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x6f, 0x73, 0x2e,
-					0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72,
-					0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22,
-					0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x21, 0x6f, 0x73, 0x2e,
-					0x49, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x28, 0x65,
-					0x72, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e,
-					0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x45, 0x78, 0x70, 0x65,
-					0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x66, 0x69, 0x6c, 0x65,
-					0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x65, 0x78, 0x69,
-					0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x77, 0x68, 0x65,
-					0x6e, 0x20, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20,
-					0x6c, 0x6f, 0x61, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20,
-					0x6e, 0x6f, 0x6e, 0x2d, 0x65, 0x78, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
-					0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x67,
-					0x6f, 0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
-					0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
-					0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6e,
-					0x76, 0x61, 0x6c, 0x69, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x28, 0x74, 0x20,
-					0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20,
-					0x7b, 0x0a, 0x20, 0x20, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
-					0x3d, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70,
-					0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x69, 0x6e,
-					0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e,
-					0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28,
-					0x22, 0x7b, 0x62, 0x6f, 0x6f, 0x6d, 0x7d, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x6e, 0x7b, 0x7d, 0x2c, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78,
+					0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45,
+					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+					0x20, 0x31, 0x7d, 0x7d, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+					0x73, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x20, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x20, 0x31, 0x20,
+					0x73, 0x65, 0x74, 0x73, 0x20, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x6c, 0x61,
+					0x79, 0x65, 0x72, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x73,
+					0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74,
+					0x20, 0x62, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64,
+					0x65, 0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x6c, 0x61, 0x79, 0x65, 0x72,
+					0x2e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28,
+					0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f,
+					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28, 0x22, 0x62, 0x6f, 0x62, 0x22,
+					0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x73, 0x28, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x29, 0x29,
+					0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48,
+					0x61, 0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x65, 0x78, 0x70, 0x20, 0x3d, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x32, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x70, 0x2e, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x26, 0x6c,
+					0x61, 0x79, 0x65, 0x72, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6c,
+					0x73, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x70, 0x20, 0x3d,
+					0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x0a, 0x20, 0x20,
+					0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x6c, 0x61,
+					0x79, 0x65, 0x72, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x69, 0x72, 0x73, 0x74,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x20, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x20,
+					0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x61, 0x70,
+					0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x22, 0x29,
+					0x0a, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28,
+					0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+					0x61, 0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29,
+					0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x20,
+					0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6f,
+					0x73, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+					0x0a, 0x20, 0x20, 0x7d, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74,
+					0x2c, 0x20, 0x6f, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61,
+					0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20, 0x22, 0x74,
+					0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x65, 0x64, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x6c, 0x6f, 0x61, 0x64,
+					0x65, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x22, 0x22, 0x0a, 0x20, 0x20, 0x5f,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64,
+					0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x57, 0x69,
+					0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28, 0x22,
+					0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74,
+					0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x28,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x3d, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53,
+					0x4f, 0x4e, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
+					0x20, 0x76, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x28, 0x29, 0x2c, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x2c,
+					0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+					0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x22, 0x29,
+					0x0a, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28,
+					0x29, 0x20, 0x2b, 0x20, 0x22, 0x2e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+					0x67, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x73,
+					0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x28,
+					0x65, 0x72, 0x72, 0x29, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x57,
+					0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74,
+					0x72, 0x69, 0x63, 0x74, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74,
+					0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x66,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6f, 0x75,
+					0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65,
+					0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+					0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e, 0x57, 0x72, 0x69,
+					0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x60, 0x7b, 0x22,
+					0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x7b,
+					0x7d, 0x2c, 0x20, 0x22, 0x4e, 0x6f, 0x74, 0x41, 0x46, 0x69, 0x65, 0x6c,
+					0x64, 0x22, 0x3a, 0x20, 0x34, 0x32, 0x7d, 0x60, 0x29, 0x0a, 0x20, 0x20,
 					0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20,
 					0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d,
 					0x6f, 0x76, 0x65, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29,
-					0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d,
-					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-					0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x22, 0x29, 0x0a,
-					0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x3d, 0x20,
-					0x6e, 0x69, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x65, 0x72, 0x72, 0x2e, 0x45,
-					0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x69,
-					0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x63, 0x68, 0x61, 0x72, 0x61,
-					0x63, 0x74, 0x65, 0x72, 0x20, 0x27, 0x62, 0x27, 0x20, 0x6c, 0x6f, 0x6f,
-					0x6b, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x62, 0x65, 0x67,
-					0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x62,
-					0x6a, 0x65, 0x63, 0x74, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x73, 0x74, 0x72,
-					0x69, 0x6e, 0x67, 0x22, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74,
-					0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x53, 0x68, 0x6f,
-					0x75, 0x6c, 0x64, 0x20, 0x67, 0x65, 0x74, 0x20, 0x61, 0x20, 0x6a, 0x73,
-					0x6f, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x77, 0x69, 0x74,
-					0x68, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65,
-					0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x76,
-					0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a,
-					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6c, 0x6f, 0x61, 0x64,
-					0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x57, 0x69, 0x74, 0x68, 0x45, 0x4e, 0x56,
-					0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74,
-					0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74, 0x65,
-					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72,
-					0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x62, 0x79, 0x74,
-					0x65, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x69,
-					0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69,
-					0x6c, 0x65, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x29,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20,
-					0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x29, 0x0a, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x28, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74,
+					0x6e, 0x61, 0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22,
+					0x29, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f,
+					0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c, 0x69, 0x63,
+					0x65, 0x22, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x72,
+					0x69, 0x63, 0x74, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20,
+					0x60, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
+					0x77, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x22, 0x4e, 0x6f,
+					0x74, 0x41, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x60, 0x29, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x52,
+					0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x53,
+					0x74, 0x72, 0x69, 0x63, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+					0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+					0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a,
+					0x3d, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72,
+					0x75, 0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x66,
+					0x75, 0x6e, 0x63, 0x28, 0x64, 0x6f, 0x63, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65,
+					0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22,
+					0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x66,
+					0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x66, 0x2e,
+					0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28,
+					0x64, 0x6f, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x65,
+					0x78, 0x70, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65,
+					0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e,
+					0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+					0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x73,
+					0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x24, 0x66, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+					0x7d, 0x7d, 0x7b, 0x7b, 0x24, 0x61, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x20, 0x24, 0x66, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73,
+					0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x76, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61,
+					0x6c, 0x28, 0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x77,
+					0x72, 0x69, 0x74, 0x65, 0x28, 0x60, 0x7b, 0x22, 0x44, 0x65, 0x66, 0x61,
+					0x75, 0x6c, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x7b, 0x7b, 0x24,
+					0x61, 0x7d, 0x7d, 0x22, 0x3a, 0x20, 0x60, 0x20, 0x2b, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x28, 0x76, 0x29, 0x20, 0x2b, 0x20, 0x60, 0x7d,
+					0x7d, 0x60, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65,
+					0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28,
+					0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x57,
+					0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28,
+					0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29, 0x2c, 0x20, 0x57, 0x69,
+					0x74, 0x68, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x28, 0x29, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73,
+					0x20, 0x7b, 0x7b, 0x24, 0x61, 0x7d, 0x7d, 0x20, 0x73, 0x68, 0x6f, 0x75,
+					0x6c, 0x64, 0x20, 0x73, 0x65, 0x74, 0x20, 0x7b, 0x7b, 0x24, 0x66, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+					0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x29,
+					0x0a, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3d,
+					0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x28, 0x60, 0x7b, 0x22, 0x44, 0x65,
+					0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x7b,
+					0x7b, 0x24, 0x61, 0x7d, 0x7d, 0x22, 0x3a, 0x20, 0x60, 0x20, 0x2b, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x76, 0x29, 0x20, 0x2b, 0x20,
+					0x60, 0x2c, 0x20, 0x22, 0x4e, 0x6f, 0x74, 0x41, 0x46, 0x69, 0x65, 0x6c,
+					0x64, 0x22, 0x3a, 0x20, 0x34, 0x32, 0x7d, 0x7d, 0x60, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e,
+					0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20,
+					0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+					0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x6e, 0x61, 0x6d, 0x65,
+					0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+					0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29, 0x2c,
+					0x20, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x28,
+					0x29, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20, 0x60, 0x6a,
+					0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x22, 0x4e, 0x6f, 0x74, 0x41,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x60, 0x2c, 0x20, 0x22, 0x74, 0x68,
+					0x65, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x66, 0x69,
+					0x65, 0x6c, 0x64, 0x73, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
+					0x62, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20,
+					0x77, 0x68, 0x65, 0x6e, 0x20, 0x7b, 0x7b, 0x24, 0x61, 0x7d, 0x7d, 0x20,
+					0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x7d, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24,
+					0x73, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x72,
+					0x75, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6e, 0x61, 0x6d, 0x65,
+					0x20, 0x3a, 0x3d, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x28, 0x60, 0x7b,
+					0x22, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3a, 0x20,
+					0x7b, 0x22, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4b, 0x65, 0x79, 0x7d, 0x7d,
+					0x22, 0x3a, 0x20, 0x7b, 0x22, 0x4e, 0x6f, 0x74, 0x41, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x22, 0x3a, 0x20, 0x34, 0x32, 0x7d, 0x7d, 0x7d, 0x60, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f,
+					0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x6e, 0x61, 0x6d,
+					0x65, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74,
+					0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x6e, 0x61, 0x6d,
+					0x65, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+					0x61, 0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x29,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57,
+					0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x6e,
+					0x61, 0x6d, 0x65, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x48, 0x6f, 0x73,
+					0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65,
+					0x22, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x72, 0x69,
+					0x63, 0x74, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c,
+					0x20, 0x60, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x75, 0x6e, 0x6b, 0x6e,
+					0x6f, 0x77, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x22, 0x4e,
+					0x6f, 0x74, 0x41, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x60, 0x2c, 0x20,
+					0x22, 0x74, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+					0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x70,
+					0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a,
+					0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+					0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+					0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a,
+					0x3d, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72,
+					0x75, 0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x63, 0x30, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70,
+					0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+					0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x31,
+					0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
+					0x31, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x68, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x4e, 0x65, 0x77, 0x48, 0x6f, 0x6c, 0x64, 0x65,
+					0x72, 0x28, 0x26, 0x63, 0x30, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x30, 0x2c,
+					0x20, 0x2a, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x28, 0x29, 0x29, 0x0a, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+					0x28, 0x31, 0x29, 0x2c, 0x20, 0x68, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
+					0x6f, 0x6e, 0x28, 0x29, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x30, 0x0a, 0x20, 0x20,
+					0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x2e,
+					0x4f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x28, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x70, 0x72, 0x65, 0x76, 0x2c, 0x20, 0x6e, 0x65, 0x78, 0x74,
+					0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63,
+					0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2b, 0x2b, 0x0a, 0x20, 0x20, 0x7d,
+					0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x73, 0x2e, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x68, 0x61, 0x6e,
+					0x67, 0x65, 0x64, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x20, 0x3a, 0x3d, 0x20, 0x30, 0x0a, 0x20, 0x20, 0x63, 0x61,
+					0x6e, 0x63, 0x65, 0x6c, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x2e, 0x4f, 0x6e, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x43, 0x68,
+					0x61, 0x6e, 0x67, 0x65, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x70, 0x72,
+					0x65, 0x76, 0x2c, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x24, 0x66, 0x2e, 0x49, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63,
+					0x74, 0x7d, 0x7d, 0x2a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2b, 0x2b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x24, 0x66, 0x2e, 0x49, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x7d,
+					0x7d, 0x26, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x63, 0x30, 0x2e,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c,
+					0x20, 0x70, 0x72, 0x65, 0x76, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x66, 0x2e,
+					0x49, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x7d, 0x7d, 0x26, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x63, 0x31, 0x2e, 0x7b, 0x7b, 0x24,
+					0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x6e, 0x65,
+					0x78, 0x74, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x70, 0x72, 0x65,
+					0x76, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x2e,
+					0x53, 0x77, 0x61, 0x70, 0x28, 0x26, 0x63, 0x31, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x30, 0x2c, 0x20, 0x2a,
+					0x70, 0x72, 0x65, 0x76, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x63, 0x31, 0x2c, 0x20, 0x2a, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x28,
+					0x29, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x75, 0x69,
+					0x6e, 0x74, 0x36, 0x34, 0x28, 0x32, 0x29, 0x2c, 0x20, 0x68, 0x2e, 0x56,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61,
+					0x6c, 0x28, 0x74, 0x2c, 0x20, 0x31, 0x2c, 0x20, 0x63, 0x68, 0x61, 0x6e,
+					0x67, 0x65, 0x73, 0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e,
+					0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x73, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x31, 0x2c, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
+					0x65, 0x64, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x6e, 0x7b, 0x7b,
+					0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x43, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+					0x65, 0x72, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65,
+					0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x63, 0x65,
+					0x22, 0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x0a, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x73, 0x77, 0x61, 0x70, 0x70,
+					0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x71,
+					0x75, 0x61, 0x6c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27,
+					0x74, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73,
+					0x0a, 0x20, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x7b,
+					0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f,
+					0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+					0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x68, 0x2e,
+					0x53, 0x77, 0x61, 0x70, 0x28, 0x26, 0x73, 0x61, 0x6d, 0x65, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x75, 0x69, 0x6e,
+					0x74, 0x36, 0x34, 0x28, 0x33, 0x29, 0x2c, 0x20, 0x68, 0x2e, 0x56, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x31, 0x2c, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
+					0x65, 0x73, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73,
+					0x20, 0x69, 0x74, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x20, 0x63, 0x6f, 0x70,
+					0x79, 0x0a, 0x20, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x2e, 0x7b, 0x7b, 0x28,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x73, 0x20, 0x30, 0x29, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x20, 0x3d, 0x20, 0x63, 0x30, 0x2e, 0x7b, 0x7b, 0x28, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x20, 0x30, 0x29, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x31, 0x2c, 0x20, 0x2a,
+					0x68, 0x2e, 0x47, 0x65, 0x74, 0x28, 0x29, 0x29, 0x0a, 0x0a, 0x20, 0x20,
+					0x2f, 0x2f, 0x20, 0x61, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+					0x62, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x63, 0x61, 0x6e, 0x63,
+					0x65, 0x6c, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x20, 0x73,
+					0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x0a,
+					0x20, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x30, 0x0a,
+					0x20, 0x20, 0x76, 0x61, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+					0x4f, 0x6e, 0x63, 0x65, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x0a,
+					0x20, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x6e, 0x63, 0x65,
+					0x20, 0x3d, 0x20, 0x68, 0x2e, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+					0x65, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x70, 0x72, 0x65, 0x76, 0x2c,
+					0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x7b, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x2b, 0x2b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x6e, 0x63,
+					0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x29, 0x0a, 0x0a, 0x20, 0x20,
+					0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x7b,
+					0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d,
+					0x20, 0x24, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d,
+					0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3d, 0x20, 0x68, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x28,
+					0x26, 0x63, 0x30, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74,
+					0x2c, 0x20, 0x31, 0x2c, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+					0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x6e, 0x43, 0x68, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63, 0x61, 0x6e,
+					0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x5f,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x68, 0x2e, 0x53, 0x77,
+					0x61, 0x70, 0x28, 0x26, 0x63, 0x31, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61,
+					0x6c, 0x28, 0x74, 0x2c, 0x20, 0x31, 0x2c, 0x20, 0x6f, 0x6e, 0x63, 0x65,
+					0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63,
+					0x72, 0x69, 0x62, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+					0x6c, 0x65, 0x64, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x75, 0x62, 0x73,
+					0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x20,
+					0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x66, 0x20,
+					0x3a, 0x3d, 0x20, 0x24, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x31, 0x2c,
+					0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x22, 0x74, 0x68,
+					0x65, 0x20, 0x4f, 0x6e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x7d, 0x7d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x73, 0x75,
+					0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77,
+					0x61, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+					0x22, 0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73,
+					0x74, 0x5f, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69,
+					0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65,
+					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b,
+					0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6e, 0x64, 0x65,
+					0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20, 0x22,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x30, 0x20, 0x3a,
+					0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x63, 0x31, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x7b,
+					0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f,
+					0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+					0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x32, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x3a, 0x3d,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x20, 0x2a, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x69, 0x66, 0x20, 0x63, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x26,
+					0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x29, 0x20, 0x7b, 0x0a, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
-					0x65, 0x72, 0x72, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x76, 0x61, 0x6c, 0x20, 0x3a, 0x3d, 0x20, 0x73, 0x74,
-					0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
-					0x65, 0x41, 0x6c, 0x6c, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28,
-					0x62, 0x79, 0x74, 0x65, 0x73, 0x29, 0x2c, 0x22, 0x24, 0x7b, 0x45, 0x4e,
-					0x56, 0x7d, 0x22, 0x2c, 0x20, 0x22, 0x45, 0x4e, 0x56, 0x5f, 0x56, 0x41,
-					0x4c, 0x55, 0x45, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
-					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65,
-					0x77, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x73, 0x74, 0x72,
-					0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x64,
-					0x65, 0x72, 0x28, 0x76, 0x61, 0x6c, 0x29, 0x29, 0x2e, 0x44, 0x65, 0x63,
-					0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
-					0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64,
-					0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x74,
-					0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29,
-					0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20,
-					0x28, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75,
+					0x6f, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+					0x64, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x20,
+					0x20, 0x7d, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x4e, 0x65, 0x77, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+					0x28, 0x26, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x76,
+					0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x6f, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x0a, 0x20, 0x20, 0x68, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x4e, 0x65, 0x77, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x28, 0x26,
+					0x63, 0x30, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+					0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3d, 0x20, 0x68, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x28, 0x26, 0x69,
+					0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28,
+					0x74, 0x2c, 0x20, 0x6f, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76,
+					0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x30, 0x2c, 0x20, 0x2a, 0x68,
+					0x2e, 0x47, 0x65, 0x74, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x61, 0x6e, 0x20,
+					0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x68,
+					0x6f, 0x75, 0x6c, 0x64, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73,
+					0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x22, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x75, 0x69, 0x6e, 0x74, 0x36,
+					0x34, 0x28, 0x31, 0x29, 0x2c, 0x20, 0x68, 0x2e, 0x56, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x28, 0x29, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x2f, 0x2f,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x20, 0x63, 0x61, 0x6e,
+					0x27, 0x74, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x6f,
+					0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x74, 0x65, 0x73, 0x74, 0x27, 0x73, 0x20, 0x67, 0x6f, 0x72,
+					0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+					0x73, 0x0a, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75,
+					0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x79, 0x27, 0x72,
+					0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x20, 0x6f, 0x6e,
+					0x63, 0x65, 0x20, 0x69, 0x74, 0x27, 0x73, 0x20, 0x64, 0x6f, 0x6e, 0x65,
+					0x0a, 0x20, 0x20, 0x64, 0x6f, 0x6e, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x6d,
+					0x61, 0x6b, 0x65, 0x28, 0x63, 0x68, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x74,
+					0x29, 0x0a, 0x20, 0x20, 0x67, 0x6f, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28,
+					0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x75, 0x6e, 0x65, 0x78,
+					0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x30, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x20, 0x3a, 0x3d,
+					0x20, 0x30, 0x3b, 0x20, 0x69, 0x20, 0x3c, 0x20, 0x31, 0x30, 0x30, 0x3b,
+					0x20, 0x69, 0x2b, 0x2b, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x69, 0x66, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x2e, 0x47,
+					0x65, 0x74, 0x28, 0x29, 0x3b, 0x20, 0x21, 0x63, 0x2e, 0x45, 0x71, 0x75,
+					0x61, 0x6c, 0x28, 0x26, 0x63, 0x30, 0x29, 0x20, 0x26, 0x26, 0x20, 0x21,
+					0x63, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x26, 0x63, 0x31, 0x29,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x75,
+					0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x2b, 0x2b, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x64, 0x6f, 0x6e, 0x65, 0x20, 0x3c,
+					0x2d, 0x20, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+					0x0a, 0x20, 0x20, 0x7d, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x6f, 0x72,
+					0x20, 0x69, 0x20, 0x3a, 0x3d, 0x20, 0x30, 0x3b, 0x20, 0x69, 0x20, 0x3c,
+					0x20, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x69, 0x2b, 0x2b, 0x20, 0x7b, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x3a, 0x3d, 0x20,
+					0x26, 0x63, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x69,
+					0x25, 0x32, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x3d, 0x20, 0x26,
+					0x63, 0x31, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x68, 0x2e,
+					0x53, 0x77, 0x61, 0x70, 0x28, 0x6e, 0x65, 0x78, 0x74, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x5a, 0x65, 0x72, 0x6f, 0x28, 0x74, 0x2c,
+					0x20, 0x3c, 0x2d, 0x64, 0x6f, 0x6e, 0x65, 0x2c, 0x20, 0x22, 0x47, 0x65,
+					0x74, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6f, 0x6e, 0x6c,
+					0x79, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20,
+					0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x28, 0x31, 0x30, 0x31, 0x29, 0x2c,
+					0x20, 0x68, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x28, 0x29,
+					0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65,
+					0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x53, 0x28,
+					0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+					0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75,
 					0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x2e, 0x47, 0x6f, 0x54,
-					0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x20, 0x3a,
-					0x3d, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x44, 0x65,
-					0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e,
-					0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70,
-					0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d,
-					0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x20,
+					0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47,
+					0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x2c,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x3a,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x20, 0x22, 0x62, 0x6f, 0x62,
+					0x22, 0x20, 0x3a, 0x20, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x6a, 0x73, 0x6f,
+					0x6e, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x3a,
+					0x20, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x6f, 0x74, 0x68,
+					0x65, 0x72, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e,
+					0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a,
+					0x20, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x3a,
+					0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+					0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e,
+					0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70,
+					0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x32, 0x7d, 0x7d,
+					0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73,
+					0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x72,
+					0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x6f, 0x74,
+					0x68, 0x65, 0x72, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x69, 0x78,
+					0x65, 0x64, 0x28, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x66, 0x73, 0x79, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x66, 0x73, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x46,
+					0x53, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x61, 0x64, 0x64, 0x20, 0x3a, 0x3d,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x7b, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68,
+					0x61, 0x6c, 0x28, 0x76, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x66, 0x73, 0x79, 0x73, 0x5b, 0x6e, 0x61, 0x6d, 0x65, 0x5d,
+					0x20, 0x3d, 0x20, 0x26, 0x66, 0x73, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+					0x61, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x7b, 0x44, 0x61, 0x74, 0x61, 0x3a,
+					0x20, 0x62, 0x7d, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x61, 0x64,
+					0x64, 0x28, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x2c, 0x20,
+					0x26, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x61, 0x64, 0x64, 0x28, 0x22, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x2c, 0x20, 0x26, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x61,
+					0x64, 0x64, 0x28, 0x22, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x22,
+					0x2c, 0x20, 0x26, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x29, 0x0a, 0x0a, 0x20,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+					0x53, 0x28, 0x66, 0x73, 0x79, 0x73, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6a,
+					0x73, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28,
+					0x74, 0x2c, 0x20, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+					0x73, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x44,
+					0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x66, 0x69, 0x6c,
+					0x65, 0x3a, 0x2f, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x74, 0x68,
+					0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62,
+					0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x72,
+					0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20,
+					0x6f, 0x74, 0x68, 0x65, 0x72, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+					0x5b, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x6f, 0x74, 0x68,
+					0x65, 0x72, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e,
+					0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65,
+					0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72,
+					0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x6f, 0x74, 0x20,
+					0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20,
+					0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20,
+					0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61,
+					0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x46, 0x53, 0x28, 0x66, 0x73, 0x79, 0x73, 0x2c, 0x20,
+					0x22, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x6a, 0x73, 0x6f,
+					0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+					0x28, 0x66, 0x73, 0x79, 0x73, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e,
+					0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x46, 0x53, 0x28, 0x66, 0x73, 0x79, 0x73, 0x2c, 0x20, 0x22, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+					0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x46, 0x53, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
+					0x66, 0x61, 0x69, 0x6c, 0x20, 0x69, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x6f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65,
+					0x20, 0x69, 0x73, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22,
+					0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65,
+					0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+					0x79, 0x74, 0x65, 0x73, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74,
+					0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20,
+					0x24, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20,
+					0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+					0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x73, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78,
+					0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45,
+					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+					0x20, 0x30, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x48, 0x6f,
+					0x73, 0x74, 0x73, 0x20, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b,
+					0x20, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x20, 0x3a, 0x20, 0x22, 0x65, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3a,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b, 0x69,
+					0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79,
+					0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61,
+					0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x7b, 0x7b, 0x2d,
+					0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x46, 0x69, 0x78, 0x65,
+					0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x2e, 0x63, 0x6c, 0x65, 0x61,
+					0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x29, 0x0a, 0x7b, 0x7b, 0x2d,
+					0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x62, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x26, 0x63, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x29, 0x0a, 0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64,
+					0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x62, 0x29,
+					0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e,
+					0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x73, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63,
+					0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2c, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x73, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x61,
+					0x64, 0x65, 0x72, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e,
+					0x4e, 0x65, 0x77, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x28, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x28, 0x62, 0x29, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x48, 0x6f, 0x73,
+					0x74, 0x73, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x48, 0x6f, 0x73, 0x74, 0x73, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69,
+					0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x44, 0x65,
+					0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45,
+					0x6d, 0x70, 0x74, 0x79, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x73, 0x2e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+					0x28, 0x29, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79,
+					0x74, 0x65, 0x73, 0x28, 0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x28, 0x22,
+					0x7b, 0x62, 0x6f, 0x6f, 0x6d, 0x7d, 0x22, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x44, 0x65,
+					0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7d, 0x7d,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c,
+					0x6f, 0x61, 0x64, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x28,
+					0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+					0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75,
+					0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+					0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x6b, 0x65, 0x79, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x70,
+					0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x6b, 0x20,
+					0x3a, 0x3d, 0x20, 0x24, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63,
+					0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x20, 0x22, 0x25, 0x71, 0x22, 0x20,
+					0x24, 0x6b, 0x7d, 0x7d, 0x3a, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x7d, 0x0a, 0x20, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x20, 0x3a,
+					0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x76, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6f, 0x75,
+					0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65,
+					0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+					0x67, 0x73, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x66, 0x2e, 0x43, 0x6c, 0x6f,
+					0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77,
+					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x66, 0x29, 0x2e, 0x45,
+					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20,
+					0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x3a, 0x3d, 0x20,
+					0x77, 0x72, 0x69, 0x74, 0x65, 0x28, 0x6b, 0x65, 0x79, 0x73, 0x29, 0x0a,
+					0x20, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52,
+					0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x3a,
+					0x3d, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x28, 0x6d, 0x61, 0x70, 0x5b,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+					0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x22, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3a, 0x20,
+					0x6b, 0x65, 0x79, 0x73, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x48,
+					0x6f, 0x73, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x7b, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x69, 0x6c,
+					0x65, 0x3a, 0x2f, 0x2f, 0x22, 0x20, 0x2b, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x3a, 0x20,
+					0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x22,
+					0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x3a, 0x20, 0x6b, 0x65,
+					0x79, 0x73, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x29, 0x0a, 0x20, 0x20,
+					0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d,
+					0x6f, 0x76, 0x65, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x0a, 0x20,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28,
+					0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x77, 0x61,
+					0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+					0x67, 0x73, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x4c, 0x65, 0x6e, 0x28, 0x74, 0x2c, 0x20, 0x77, 0x61,
+					0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x2c, 0x20, 0x33, 0x2a, 0x6c, 0x65,
+					0x6e, 0x28, 0x6b, 0x65, 0x79, 0x73, 0x29, 0x2c, 0x20, 0x22, 0x74, 0x68,
+					0x65, 0x72, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62,
+					0x65, 0x20, 0x61, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x20,
+					0x66, 0x6f, 0x72, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x64, 0x65, 0x70,
+					0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c,
+					0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x2c,
+					0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x77, 0x61,
+					0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x5f, 0x2c, 0x20, 0x77, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x50, 0x72,
+					0x65, 0x66, 0x69, 0x78, 0x28, 0x77, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65,
+					0x2b, 0x22, 0x3a, 0x20, 0x22, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x50, 0x72, 0x65,
+					0x66, 0x69, 0x78, 0x28, 0x77, 0x2c, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x2b, 0x22, 0x3a, 0x20, 0x22, 0x29, 0x2c, 0x20, 0x22,
+					0x74, 0x68, 0x65, 0x20, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x20,
+					0x25, 0x71, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x73, 0x74,
+					0x61, 0x72, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65,
+					0x22, 0x2c, 0x20, 0x77, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x0a, 0x20,
+					0x20, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x69,
+					0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69,
+					0x6c, 0x65, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+					0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x62, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4c, 0x65, 0x6e,
+					0x28, 0x74, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+					0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x28, 0x29, 0x2c, 0x20,
+					0x33, 0x2a, 0x6c, 0x65, 0x6e, 0x28, 0x6b, 0x65, 0x79, 0x73, 0x29, 0x29,
+					0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x46, 0x69, 0x78, 0x65,
+					0x64, 0x7d, 0x7d, 0x0a, 0x2f, 0x2f, 0x20, 0x63, 0x6c, 0x65, 0x61, 0x72,
+					0x46, 0x69, 0x78, 0x65, 0x64, 0x20, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62,
+					0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e,
+					0x20, 0x69, 0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20,
+					0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x29, 0x20, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x69,
+					0x78, 0x65, 0x64, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x6f, 0x20, 0x3a, 0x3d,
+					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x2e, 0x63, 0x6c, 0x65,
+					0x61, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x6e, 0x61, 0x6d, 0x65,
+					0x5d, 0x20, 0x3d, 0x20, 0x6f, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x2f, 0x2f, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64,
+					0x65, 0x6e, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20,
+					0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+					0x20, 0x2a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x64, 0x65, 0x6e, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+					0x63, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+					0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x43, 0x6c,
+					0x6f, 0x6e, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x6f, 0x20, 0x3a, 0x3d,
+					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x4f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x6e, 0x61, 0x6d, 0x65, 0x5d,
+					0x0a, 0x20, 0x20, 0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+					0x65, 0x46, 0x72, 0x6f, 0x6d, 0x28, 0x26, 0x6f, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x2a, 0x63, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c,
+					0x6f, 0x61, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x74, 0x20, 0x2a,
+					0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b,
+					0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6e,
+					0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73,
+					0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63, 0x20,
+					0x3a, 0x3d, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x44,
+					0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x20, 0x7b, 0x7b, 0x69,
+					0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79,
+					0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61,
+					0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20,
 					0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-					0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x20, 0x22, 0x62, 0x6f,
-					0x62, 0x22, 0x20, 0x3a, 0x20, 0x22, 0x24, 0x7b, 0x45, 0x4e, 0x56, 0x7d,
-					0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x4f, 0x76, 0x65, 0x72,
-					0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b,
-					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-					0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x22, 0x24, 0x7b, 0x45, 0x4e, 0x56, 0x7d, 0x22,
-					0x20, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24,
-					0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-					0x75, 0x65, 0x73, 0x20, 0x32, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x66, 0x2c,
-					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x69, 0x6f, 0x75, 0x74, 0x69,
-					0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x22,
-					0x22, 0x2c, 0x20, 0x22, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x6a, 0x73,
-					0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72,
-					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28,
-					0x22, 0x55, 0x61, 0x6e, 0x62, 0x6c, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63,
-					0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x20, 0x66,
-					0x69, 0x6c, 0x65, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72,
-					0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x6a, 0x73, 0x6f,
-					0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-					0x28, 0x66, 0x29, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x26,
-					0x63, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+					0x5d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65,
+					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x73, 0x2e, 0x47, 0x6f, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31, 0x7d, 0x7d, 0x2c, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20,
+					0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73,
+					0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x26,
+					0x63, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+					0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x62, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+					0x69, 0x6e, 0x73, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x6f, 0x76, 0x65,
+					0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x65, 0x78,
+					0x61, 0x6d, 0x70, 0x6c, 0x65, 0x20, 0x73, 0x65, 0x74, 0x73, 0x20, 0x22,
+					0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x22, 0x5d, 0x20, 0x3d, 0x20, 0x2a, 0x63, 0x2e, 0x44, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x28,
+					0x29, 0x0a, 0x20, 0x20, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61,
+					0x6c, 0x28, 0x26, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46,
+					0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x62, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20,
+					0x22, 0x61, 0x6e, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x20, 0x73, 0x65, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x73,
+					0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74,
+					0x20, 0x62, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64,
+					0x65, 0x6e, 0x2c, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20,
+					0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20,
+					0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x63, 0x2e, 0x63,
+					0x6c, 0x65, 0x61, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28, 0x29, 0x0a,
+					0x20, 0x20, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28,
+					0x26, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f,
+					0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x62, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x0a, 0x20, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20,
+					0x3a, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24,
+					0x73, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61,
+					0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x31,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65,
+					0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22,
+					0x66, 0x69, 0x78, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4e, 0x65, 0x77, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x66,
+					0x29, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x26, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x66,
+					0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x64,
+					0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+					0x76, 0x65, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x29,
+					0x0a, 0x20, 0x20, 0x63, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x3d,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x22, 0x62, 0x6f, 0x62, 0x22,
+					0x3a, 0x20, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x22, 0x20,
+					0x2b, 0x20, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x7d, 0x0a,
+					0x20, 0x20, 0x62, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x6a,
+					0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28,
+					0x26, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f,
+					0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x62, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+					0x61, 0x69, 0x6e, 0x73, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2e,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x6f, 0x76,
+					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x3a, 0x2f, 0x2f, 0x22, 0x2b, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x2b, 0x22, 0x20, 0x73, 0x65, 0x74, 0x73, 0x20,
+					0x22, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x76, 0x65, 0x72,
+					0x72, 0x69, 0x64, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x61, 0x6c, 0x73, 0x6f, 0x20, 0x62,
+					0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x29, 0x0a,
+					0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73,
+					0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+					0x64, 0x6f, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28,
+					0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x5b, 0x5d, 0x62,
+					0x79, 0x74, 0x65, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x62, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+					0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x6d, 0x61, 0x70,
+					0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x22, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69,
+					0x6f, 0x6e, 0x2c, 0x20, 0x22, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+					0x73, 0x22, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+					0x7b, 0x7d, 0x7b, 0x7d, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x62, 0x0a,
+					0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+					0x42, 0x79, 0x74, 0x65, 0x73, 0x28, 0x64, 0x6f, 0x63, 0x28, 0x53, 0x63,
+					0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x29,
+					0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e,
+					0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+					0x79, 0x74, 0x65, 0x73, 0x28, 0x64, 0x6f, 0x63, 0x28, 0x53, 0x63, 0x68,
+					0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x2b,
+					0x20, 0x31, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69,
+					0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x28,
+					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x29, 0x2c, 0x20, 0x22, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x20, 0x74,
+					0x68, 0x61, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65,
+					0x6d, 0x61, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29,
+					0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20,
+					0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65,
+					0x73, 0x28, 0x64, 0x6f, 0x63, 0x28, 0x22, 0x31, 0x22, 0x29, 0x29, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c,
+					0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73,
+					0x28, 0x64, 0x6f, 0x63, 0x28, 0x31, 0x2e, 0x35, 0x29, 0x29, 0x0a, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x0a,
+					0x20, 0x20, 0x66, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
+					0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x46,
+					0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x66,
+					0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x28, 0x64, 0x6f, 0x63, 0x28, 0x53,
+					0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x29, 0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
 					0x28, 0x29, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f,
 					0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x66, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x28, 0x29, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x4a, 0x53,
-					0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6c,
-					0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x57, 0x69, 0x74, 0x68,
-					0x45, 0x4e, 0x56, 0x0a, 0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61,
+					0x61, 0x6d, 0x65, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69,
+					0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x66, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68,
+					0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x28, 0x22, 0x61, 0x6c,
+					0x69, 0x63, 0x65, 0x22, 0x29, 0x2c, 0x20, 0x57, 0x69, 0x74, 0x68, 0x53,
+					0x74, 0x72, 0x69, 0x63, 0x74, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20, 0x22,
+					0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20,
+					0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x61, 0x6e,
+					0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x66, 0x69, 0x65,
+					0x6c, 0x64, 0x22, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x61,
+					0x72, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x28, 0x74,
+					0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29,
+					0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x73, 0x20, 0x3a, 0x3d, 0x20,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+					0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x67,
+					0x65, 0x72, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x20, 0x66,
+					0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74,
+					0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x61,
+					0x72, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x61, 0x73,
+					0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x61, 0x72, 0x65, 0x2c, 0x20, 0x77,
+					0x68, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66,
+					0x69, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61,
+					0x74, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x0a, 0x20,
+					0x20, 0x76, 0x61, 0x72, 0x20, 0x65, 0x78, 0x70, 0x20, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x20,
+					0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b,
+					0x7d, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x2d, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x73, 0x2e, 0x46,
+					0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x7b, 0x7b,
+					0x2d, 0x20, 0x69, 0x66, 0x20, 0x65, 0x71, 0x20, 0x24, 0x66, 0x2e, 0x54,
+					0x79, 0x70, 0x65, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x39, 0x32,
+					0x32, 0x33, 0x33, 0x37, 0x32, 0x30, 0x33, 0x36, 0x38, 0x35, 0x34, 0x37,
+					0x37, 0x35, 0x38, 0x30, 0x37, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x66, 0x61,
+					0x75, 0x6c, 0x74, 0x73, 0x5b, 0x7b, 0x7b, 0x70, 0x72, 0x69, 0x6e, 0x74,
+					0x66, 0x20, 0x22, 0x25, 0x71, 0x22, 0x20, 0x24, 0x66, 0x2e, 0x4b, 0x65,
+					0x79, 0x7d, 0x7d, 0x5d, 0x20, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28, 0x22, 0x39, 0x32, 0x32, 0x33,
+					0x33, 0x37, 0x32, 0x30, 0x33, 0x36, 0x38, 0x35, 0x34, 0x37, 0x37, 0x35,
+					0x38, 0x30, 0x37, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x7b, 0x7b, 0x2d, 0x20,
+					0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x65, 0x71, 0x20, 0x24,
+					0x66, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x20, 0x22, 0x75, 0x69, 0x6e, 0x74,
+					0x36, 0x34, 0x22, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x65, 0x78, 0x70, 0x2e,
+					0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20,
+					0x3d, 0x20, 0x31, 0x38, 0x34, 0x34, 0x36, 0x37, 0x34, 0x34, 0x30, 0x37,
+					0x33, 0x37, 0x30, 0x39, 0x35, 0x35, 0x31, 0x36, 0x31, 0x35, 0x0a, 0x20,
+					0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5b, 0x7b, 0x7b,
+					0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x20, 0x22, 0x25, 0x71, 0x22, 0x20,
+					0x24, 0x66, 0x2e, 0x4b, 0x65, 0x79, 0x7d, 0x7d, 0x5d, 0x20, 0x3d, 0x20,
+					0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28,
+					0x22, 0x31, 0x38, 0x34, 0x34, 0x36, 0x37, 0x34, 0x34, 0x30, 0x37, 0x33,
+					0x37, 0x30, 0x39, 0x35, 0x35, 0x31, 0x36, 0x31, 0x35, 0x22, 0x29, 0x0a,
+					0x20, 0x20, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x5b, 0x5d, 0x69, 0x6e, 0x74, 0x7b, 0x31, 0x2c, 0x20, 0x53,
+					0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x7d, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x62, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d,
+					0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x6d, 0x61, 0x70, 0x5b, 0x73,
+					0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+					0x61, 0x63, 0x65, 0x7b, 0x7d, 0x7b, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69,
+					0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x2c, 0x20, 0x22, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22,
+					0x3a, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x7d, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74,
+					0x65, 0x73, 0x28, 0x62, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x20, 0x22, 0x76,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x25, 0x64, 0x22, 0x2c, 0x20,
+					0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x2d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x24,
+					0x66, 0x20, 0x3a, 0x3d, 0x20, 0x24, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+					0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x2d,
+					0x20, 0x69, 0x66, 0x20, 0x6f, 0x72, 0x20, 0x28, 0x65, 0x71, 0x20, 0x24,
+					0x66, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x36,
+					0x34, 0x22, 0x29, 0x20, 0x28, 0x65, 0x71, 0x20, 0x24, 0x66, 0x2e, 0x54,
+					0x79, 0x70, 0x65, 0x20, 0x22, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x22,
+					0x29, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x65, 0x78, 0x70, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x7b,
+					0x7b, 0x24, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x20,
+					0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x25, 0x64, 0x22,
+					0x2c, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x67, 0x72,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65,
+					0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6d, 0x20, 0x3a, 0x3d,
+					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6d, 0x69, 0x67, 0x72, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x63, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+					0x65, 0x7b, 0x7d, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x70, 0x75,
+					0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x63, 0x2c, 0x20, 0x6d, 0x2e, 0x70,
+					0x61, 0x74, 0x68, 0x2c, 0x20, 0x39, 0x30, 0x2e, 0x30, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x4e,
+					0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x6d, 0x2e,
+					0x61, 0x70, 0x70, 0x6c, 0x79, 0x28, 0x63, 0x29, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x20, 0x6d, 0x2e, 0x6f,
+					0x70, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x61, 0x73, 0x65,
+					0x20, 0x22, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x3a, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x76, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20,
+					0x74, 0x61, 0x6b, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x63, 0x2c, 0x20,
+					0x6d, 0x2e, 0x74, 0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65,
+					0x28, 0x74, 0x2c, 0x20, 0x6f, 0x6b, 0x2c, 0x20, 0x22, 0x25, 0x76, 0x20,
+					0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x6d, 0x6f,
+					0x76, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x6d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x6d, 0x2e, 0x74, 0x6f,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x39, 0x30, 0x2e, 0x30, 0x2c, 0x20, 0x76, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3d, 0x20,
+					0x74, 0x61, 0x6b, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x63, 0x2c, 0x20,
+					0x6d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x46, 0x61,
+					0x6c, 0x73, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x6b, 0x2c, 0x20, 0x22,
+					0x25, 0x76, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65,
+					0x20, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x25, 0x76,
+					0x22, 0x2c, 0x20, 0x6d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x2c, 0x20, 0x6d,
+					0x2e, 0x74, 0x6f, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63, 0x61, 0x73,
+					0x65, 0x20, 0x22, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x3a, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x6b, 0x20,
+					0x3a, 0x3d, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x28,
+					0x63, 0x2c, 0x20, 0x6d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x28, 0x74, 0x2c, 0x20, 0x6f, 0x6b,
+					0x2c, 0x20, 0x22, 0x25, 0x76, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
+					0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
+					0x2c, 0x20, 0x6d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x63, 0x61, 0x73, 0x65, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x76,
+					0x65, 0x72, 0x74, 0x22, 0x3a, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x5f, 0x2c, 0x20, 0x6f, 0x6b, 0x20, 0x3a, 0x3d, 0x20, 0x74, 0x61, 0x6b,
+					0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x63, 0x2c, 0x20, 0x6d, 0x2e, 0x70,
+					0x61, 0x74, 0x68, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x28,
+					0x74, 0x2c, 0x20, 0x6f, 0x6b, 0x2c, 0x20, 0x22, 0x25, 0x76, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+					0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x22, 0x2c, 0x20, 0x6d, 0x2e, 0x70,
+					0x61, 0x74, 0x68, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+					0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54,
+					0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4a,
+					0x53, 0x4f, 0x4e, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69,
+					0x6e, 0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x74, 0x63,
+					0x73, 0x20, 0x3a, 0x3d, 0x20, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x75, 0x63,
+					0x74, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72,
+					0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x63,
+					0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x78, 0x70,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x7d, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x39, 0x30, 0x2e, 0x30, 0x2c, 0x20,
+					0x22, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2c, 0x20, 0x22,
+					0x31, 0x6d, 0x33, 0x30, 0x73, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x22, 0x31, 0x30, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x65,
+					0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x31, 0x30, 0x73,
+					0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x31, 0x35, 0x30,
+					0x30, 0x2e, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+					0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x31, 0x2e,
+					0x35, 0x73, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x38,
+					0x30, 0x38, 0x30, 0x2e, 0x30, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x22, 0x2c, 0x20, 0x22, 0x38, 0x30, 0x38, 0x30, 0x22, 0x7d,
+					0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x74, 0x72, 0x75, 0x65, 0x2c,
+					0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x20, 0x22,
+					0x74, 0x72, 0x75, 0x65, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+					0x28, 0x22, 0x39, 0x30, 0x22, 0x29, 0x2c, 0x20, 0x22, 0x73, 0x65, 0x63,
+					0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x31, 0x6d, 0x33, 0x30,
+					0x73, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x6a, 0x73,
+					0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x28, 0x22, 0x38,
+					0x30, 0x38, 0x30, 0x22, 0x29, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x22, 0x2c, 0x20, 0x22, 0x38, 0x30, 0x38, 0x30, 0x22, 0x7d,
+					0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x22, 0x38, 0x30, 0x38, 0x30,
+					0x22, 0x2c, 0x20, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c,
+					0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+					0x28, 0x22, 0x38, 0x30, 0x38, 0x30, 0x22, 0x29, 0x7d, 0x2c, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x38, 0x30, 0x38, 0x30, 0x2e, 0x30, 0x2c, 0x20,
+					0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c, 0x20, 0x38, 0x30,
+					0x38, 0x30, 0x2e, 0x30, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+					0x20, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x74, 0x63, 0x20, 0x3a,
+					0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x63, 0x73, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4a,
+					0x53, 0x4f, 0x4e, 0x28, 0x74, 0x63, 0x2e, 0x76, 0x2c, 0x20, 0x74, 0x63,
+					0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+					0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74,
+					0x2c, 0x20, 0x74, 0x63, 0x2e, 0x65, 0x78, 0x70, 0x2c, 0x20, 0x76, 0x2c,
+					0x20, 0x22, 0x25, 0x76, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x25, 0x73, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x20, 0x74, 0x63, 0x2e, 0x76, 0x2c, 0x20,
+					0x74, 0x63, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+					0x6e, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+					0x72, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x22, 0x70, 0x6f, 0x72, 0x74,
+					0x22, 0x2c, 0x20, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x29,
+					0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f, 0x61,
+					0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65,
+					0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+					0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x66, 0x2c, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54,
+					0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20,
+					0x22, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x29, 0x0a, 0x20,
+					0x20, 0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20,
+					0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x66,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x5f,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64,
+					0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22,
+					0x22, 0x2c, 0x20, 0x22, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20,
+					0x21, 0x6f, 0x73, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+					0x73, 0x74, 0x28, 0x65, 0x72, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22,
+					0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20,
+					0x66, 0x69, 0x6c, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74,
+					0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67,
+					0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x66, 0x72, 0x6f,
+					0x6d, 0x20, 0x61, 0x20, 0x6e, 0x6f, 0x6e, 0x2d, 0x65, 0x78, 0x69, 0x73,
+					0x74, 0x61, 0x6e, 0x74, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x62,
+					0x75, 0x74, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f, 0x4c, 0x6f,
+					0x61, 0x64, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4a, 0x73, 0x6f,
+					0x6e, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+					0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x66, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3a, 0x3d, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e,
+					0x54, 0x65, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c,
+					0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x29, 0x0a,
+					0x20, 0x20, 0x66, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x28, 0x22, 0x7b, 0x62, 0x6f, 0x6f, 0x6d, 0x7d, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x66, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28,
+					0x29, 0x0a, 0x20, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73,
+					0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x5f, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20,
+					0x22, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x65,
+					0x72, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x20, 0x21,
+					0x3d, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x63,
+					0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x20, 0x27, 0x62, 0x27,
+					0x20, 0x6c, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72,
+					0x20, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6f,
+					0x66, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x6b, 0x65, 0x79,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28,
+					0x22, 0x53, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x67, 0x65, 0x74, 0x20,
+					0x61, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x76,
+					0x61, 0x6c, 0x69, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20,
+					0x66, 0x69, 0x6c, 0x65, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x67, 0x6f,
+					0x74, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x57, 0x69, 0x74,
+					0x68, 0x45, 0x4e, 0x56, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+					0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x76, 0x20,
+					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x52, 0x65,
+					0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x28, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+					0x61, 0x6d, 0x65, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7d, 0x0a, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x6c, 0x20, 0x3a,
+					0x3d, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x52, 0x65,
+					0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x28, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x28, 0x62, 0x79, 0x74, 0x65, 0x73, 0x29, 0x2c, 0x22,
+					0x24, 0x7b, 0x45, 0x4e, 0x56, 0x7d, 0x22, 0x2c, 0x20, 0x22, 0x45, 0x4e,
+					0x56, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x22, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6a, 0x73, 0x6f,
+					0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72,
+					0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4e, 0x65, 0x77,
+					0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x28, 0x76, 0x61, 0x6c, 0x29, 0x29,
+					0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x28, 0x76, 0x29, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x5f,
+					0x4c, 0x6f, 0x61, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4a, 0x53,
+					0x4f, 0x4e, 0x28, 0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+					0x67, 0x2e, 0x54, 0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x20, 0x24, 0x74,
+					0x20, 0x3a, 0x3d, 0x20, 0x28, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x2e,
+					0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x20, 0x22, 0x43, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29,
+					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x20,
+					0x7b, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x45,
+					0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+					0x20, 0x31, 0x7d, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x48, 0x6f,
+					0x73, 0x74, 0x73, 0x20, 0x3a, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b,
+					0x20, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x20, 0x3a, 0x20, 0x22, 0x24, 0x7b,
+					0x45, 0x4e, 0x56, 0x7d, 0x22, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x20, 0x3a, 0x20,
+					0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x24, 0x7b, 0x45,
+					0x4e, 0x56, 0x7d, 0x22, 0x20, 0x3a, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x64,
+					0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+					0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x32, 0x7d, 0x7d, 0x2c,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x7d, 0x0a,
+					0x20, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e,
+					0x20, 0x3a, 0x3d, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+					0x64, 0x65, 0x73, 0x5b, 0x22, 0x24, 0x7b, 0x45, 0x4e, 0x56, 0x7d, 0x22,
+					0x5d, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61,
+					0x73, 0x46, 0x69, 0x78, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x63,
+					0x2e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x28,
+					0x29, 0x0a, 0x20, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64,
+					0x65, 0x6e, 0x20, 0x3d, 0x20, 0x63, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x72,
+					0x69, 0x64, 0x64, 0x65, 0x6e, 0x28, 0x22, 0x24, 0x7b, 0x45, 0x4e, 0x56,
+					0x7d, 0x22, 0x29, 0x0a, 0x7b, 0x7b, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x66, 0x2c, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x69, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+					0x46, 0x69, 0x6c, 0x65, 0x28, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x75,
+					0x73, 0x74, 0x6f, 0x6d, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x20,
+					0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x46,
+					0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x61, 0x6e, 0x62, 0x6c,
+					0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20,
+					0x74, 0x65, 0x6d, 0x70, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x20, 0x25,
+					0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d,
+					0x0a, 0x20, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x45,
+					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x66, 0x29, 0x2e, 0x45, 0x6e,
+					0x63, 0x6f, 0x64, 0x65, 0x28, 0x26, 0x63, 0x29, 0x0a, 0x20, 0x20, 0x66,
+					0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x20, 0x20, 0x64,
+					0x65, 0x66, 0x65, 0x72, 0x20, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+					0x76, 0x65, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x29,
+					0x0a, 0x0a, 0x20, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64,
+					0x65, 0x72, 0x20, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f,
+					0x4e, 0x45, 0x57, 0x69, 0x74, 0x68, 0x45, 0x4e, 0x56, 0x0a, 0x20, 0x20,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x22,
+					0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e,
+					0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x63,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+					0x74, 0x73, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c,
+					0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e,
+					0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73,
+					0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20,
+					0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2c, 0x20, 0x62, 0x75, 0x74,
+					0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x2c, 0x20, 0x65, 0x78,
+					0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x25, 0x23, 0x76, 0x2c,
+					0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x23, 0x76, 0x22, 0x2c, 0x20, 0x63,
+					0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20, 0x2a,
+					0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x29, 0x0a, 0x0a, 0x20, 0x20, 0x4a,
+					0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x57, 0x69, 0x74,
+					0x68, 0x45, 0x4e, 0x56, 0x0a, 0x20, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+					0x67, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61,
 					0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20,
-					0x22, 0x22, 0x2c, 0x20, 0x22, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66,
-					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
-					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61,
-					0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-					0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x61,
-					0x64, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a,
-					0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20,
-					0x20, 0x7d, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-					0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e,
-					0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2c, 0x20, 0x2a, 0x63,
-					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64,
-					0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
-					0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-					0x74, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e,
-					0x27, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e,
-					0x67, 0x20, 0x25, 0x23, 0x76, 0x2c, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25,
-					0x23, 0x76, 0x22, 0x2c, 0x20, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
-					0x6c, 0x74, 0x73, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-					0x29, 0x0a, 0x0a, 0x20, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x61,
-					0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x53,
-					0x4f, 0x4e, 0x45, 0x57, 0x69, 0x74, 0x68, 0x45, 0x4e, 0x56, 0x0a, 0x20,
-					0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20, 0x65, 0x72, 0x72,
-					0x20, 0x3d, 0x20, 0x4c, 0x6f, 0x61, 0x64, 0x28, 0x66, 0x2e, 0x4e, 0x61,
-					0x6d, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x62,
-					0x6f, 0x62, 0x22, 0x29, 0x0a, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72,
-					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x74, 0x2e, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x66, 0x28,
-					0x22, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20,
-					0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e,
-					0x67, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x25, 0x76,
-					0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a,
-					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x2e, 0x45, 0x71,
-					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65,
-					0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x24, 0x7b, 0x45, 0x4e,
-					0x56, 0x7d, 0x22, 0x5d, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-					0x67, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64,
-					0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
-					0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-					0x74, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e,
-					0x27, 0x74, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e,
-					0x67, 0x20, 0x25, 0x23, 0x76, 0x2c, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25,
-					0x23, 0x76, 0x5c, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
-					0x73, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x76,
-					0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x5b, 0x22, 0x24, 0x7b, 0x45,
-					0x4e, 0x56, 0x7d, 0x22, 0x5d, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66,
-					0x69, 0x67, 0x2c, 0x20, 0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-					0x64, 0x65, 0x73, 0x29, 0x0a, 0x7d,
+					0x22, 0x22, 0x2c, 0x20, 0x22, 0x62, 0x6f, 0x62, 0x22, 0x29, 0x0a, 0x20,
+					0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x2e, 0x46,
+					0x61, 0x74, 0x61, 0x6c, 0x66, 0x28, 0x22, 0x55, 0x6e, 0x65, 0x78, 0x70,
+					0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20,
+					0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+					0x69, 0x67, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x29, 0x0a, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c,
+					0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x2c,
+					0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x22, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20, 0x63, 0x6f,
+					0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68,
+					0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2c, 0x20, 0x62, 0x75,
+					0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x2c, 0x20, 0x65,
+					0x78, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x25, 0x23, 0x76,
+					0x2c, 0x20, 0x67, 0x6f, 0x74, 0x20, 0x25, 0x23, 0x76, 0x5c, 0x6e, 0x4f,
+					0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x3a, 0x20, 0x25, 0x76,
+					0x22, 0x2c, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65,
+					0x6e, 0x2c, 0x20, 0x2a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x20,
+					0x63, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x29,
+					0x0a, 0x7d,
 				},
 				fi: FileInfo{
 					name:    "config_test.go.template",
-					size:    7722,
-					modTime: time.Unix(0, 1619806641339561998),
+					size:    38210,
+					modTime: time.Unix(0, 1792432537809744635),
+					isDir:   false,
+				},
+			}, "/mock_config.go.template": {
+				data: []byte{
+					0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x50,
+					0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69,
+					0x6c, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20,
+					0x66, 0x61, 0x6b, 0x65, 0x20, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+					0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x20, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2c, 0x20, 0x66,
+					0x6f, 0x72, 0x20, 0x75, 0x6e, 0x69, 0x74, 0x0a, 0x2f, 0x2f, 0x20, 0x74,
+					0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+					0x6f, 0x64, 0x65, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x75, 0x73, 0x65,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x0a, 0x2f, 0x2f, 0x0a, 0x2f, 0x2f,
+					0x20, 0x2a, 0x2a, 0x2a, 0x20, 0x54, 0x48, 0x49, 0x53, 0x20, 0x49, 0x53,
+					0x20, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44, 0x20, 0x43,
+					0x4f, 0x44, 0x45, 0x3a, 0x20, 0x44, 0x4f, 0x20, 0x4e, 0x4f, 0x54, 0x20,
+					0x45, 0x44, 0x49, 0x54, 0x20, 0x2a, 0x2a, 0x2a, 0x0a, 0x0a, 0x69, 0x6d,
+					0x70, 0x6f, 0x72, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x22, 0x73, 0x79, 0x6e,
+					0x63, 0x22, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x4d,
+					0x6f, 0x63, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x7d, 0x7d,
+					0x0a, 0x09, 0x22, 0x7b, 0x7b, 0x2e, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6d,
+					0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x63, 0x6f, 0x75,
+					0x6e, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c,
+					0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x74,
+					0x68, 0x6f, 0x64, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6d, 0x6f,
+					0x63, 0x6b, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6d, 0x6f, 0x63, 0x6b,
+					0x43, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+					0x20, 0x7b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x20, 0x73, 0x79,
+					0x6e, 0x63, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x78, 0x0a, 0x09, 0x63, 0x61,
+					0x6c, 0x6c, 0x73, 0x20, 0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x5d, 0x69, 0x6e, 0x74, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+					0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x6d, 0x65, 0x74,
+					0x68, 0x6f, 0x64, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e,
+					0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x28, 0x6d, 0x20, 0x2a, 0x6d, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6c,
+					0x6c, 0x73, 0x29, 0x20, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x28, 0x6d, 0x65,
+					0x74, 0x68, 0x6f, 0x64, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29,
+					0x20, 0x69, 0x6e, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x6d, 0x2e, 0x6c, 0x6f,
+					0x63, 0x6b, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a, 0x09, 0x64,
+					0x65, 0x66, 0x65, 0x72, 0x20, 0x6d, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+					0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6d, 0x2e, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+					0x5b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5d, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x6d, 0x20, 0x2a, 0x6d, 0x6f, 0x63,
+					0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x29, 0x20, 0x63, 0x61, 0x6c, 0x6c,
+					0x65, 0x64, 0x28, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x6d, 0x2e, 0x6c,
+					0x6f, 0x63, 0x6b, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a, 0x09,
+					0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x6d, 0x2e, 0x6c, 0x6f, 0x63, 0x6b,
+					0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x28, 0x29, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x6d, 0x2e, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x3d, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6d, 0x2e, 0x63,
+					0x61, 0x6c, 0x6c, 0x73, 0x20, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28,
+					0x6d, 0x61, 0x70, 0x5b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5d, 0x69,
+					0x6e, 0x74, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x6d, 0x2e, 0x63, 0x61,
+					0x6c, 0x6c, 0x73, 0x5b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5d, 0x2b,
+					0x2b, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x24, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+					0x74, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e,
+					0x57, 0x69, 0x74, 0x68, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x7d, 0x7d,
+					0x0a, 0x7b, 0x7b, 0x24, 0x74, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x49, 0x6d,
+					0x70, 0x6c, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "mock_config.go.template",
+					size:    788,
+					modTime: time.Unix(0, 1792424227729457730),
+					isDir:   false,
+				},
+			}, "/mock_config_test.go.template": {
+				data: []byte{
+					0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x50,
+					0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x2a, 0x2a, 0x2a, 0x20, 0x54, 0x48, 0x49,
+					0x53, 0x20, 0x49, 0x53, 0x20, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
+					0x45, 0x44, 0x20, 0x43, 0x4f, 0x44, 0x45, 0x3a, 0x20, 0x44, 0x4f, 0x20,
+					0x4e, 0x4f, 0x54, 0x20, 0x45, 0x44, 0x49, 0x54, 0x20, 0x2a, 0x2a, 0x2a,
+					0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x28, 0x0a, 0x09,
+					0x22, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x0a, 0x09, 0x22,
+					0x74, 0x69, 0x6d, 0x65, 0x22, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6d, 0x70, 0x6f,
+					0x72, 0x74, 0x73, 0x20, 0x22, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+					0x22, 0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x7d, 0x7d, 0x0a, 0x09,
+					0x22, 0x7b, 0x7b, 0x2e, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x0a, 0x09, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+					0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x74, 0x63, 0x68,
+					0x72, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x66, 0x79, 0x2f, 0x61, 0x73,
+					0x73, 0x65, 0x72, 0x74, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74,
+					0x69, 0x6d, 0x65, 0x20, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20,
+					0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x66,
+					0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x73, 0x20, 0x75, 0x73, 0x65, 0x20, 0x69, 0x74, 0x0a,
+					0x76, 0x61, 0x72, 0x20, 0x5f, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44,
+					0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x7b, 0x7b, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+					0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x7d, 0x7d, 0x0a, 0x2f, 0x2f, 0x20,
+					0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70,
+					0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x74,
+					0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x0a, 0x76, 0x61,
+					0x72, 0x20, 0x5f, 0x20, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x72,
+					0x61, 0x6e, 0x67, 0x65, 0x20, 0x24, 0x6e, 0x2c, 0x20, 0x24, 0x74, 0x20,
+					0x3a, 0x3d, 0x20, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x7d,
+					0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x24, 0x74, 0x2e, 0x57, 0x69, 0x74,
+					0x68, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x28,
+					0x74, 0x20, 0x2a, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b,
+					0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x24, 0x74, 0x2e, 0x47, 0x6f, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x30, 0x7d, 0x7d, 0x0a, 0x09, 0x6d,
+					0x20, 0x3a, 0x3d, 0x20, 0x4e, 0x65, 0x77, 0x4d, 0x6f, 0x63, 0x6b, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x28,
+					0x26, 0x63, 0x29, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x20, 0x5f, 0x20, 0x7b,
+					0x7b, 0x24, 0x6e, 0x7d, 0x7d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20,
+					0x3d, 0x20, 0x6d, 0x0a, 0x09, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x24, 0x66, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x74,
+					0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x09,
+					0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+					0x28, 0x74, 0x2c, 0x20, 0x63, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x47,
+					0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28,
+					0x29, 0x2c, 0x20, 0x6d, 0x2e, 0x7b, 0x7b, 0x24, 0x66, 0x2e, 0x47, 0x65,
+					0x74, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x29,
+					0x29, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x2e, 0x45, 0x71,
+					0x75, 0x61, 0x6c, 0x28, 0x74, 0x2c, 0x20, 0x31, 0x2c, 0x20, 0x6d, 0x2e,
+					0x43, 0x61, 0x6c, 0x6c, 0x73, 0x28, 0x22, 0x7b, 0x7b, 0x24, 0x66, 0x2e,
+					0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x22, 0x29, 0x29, 0x0a, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "mock_config_test.go.template",
+					size:    762,
+					modTime: time.Unix(0, 1792426017064120271),
 					isDir:   false,
 				},
 			},
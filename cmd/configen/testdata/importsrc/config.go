@@ -0,0 +1,89 @@
+// Package importsrc contains hand written config structs, used to test the import command
+package importsrc
+
+import "time"
+
+// Duration is a custom duration type
+type Duration int64
+
+// Config contains the service configuration
+type Config struct {
+	// HTTP contains the config for the HTTP service
+	HTTP HTTPServer
+	// LogLevels specifies the log levels per package
+	LogLevels []RepoLogLevel
+	Debug     *bool // Debug enables the debug mode
+
+	internal string
+}
+
+// HTTPServer contains the configuration of the HTTP service
+type HTTPServer struct {
+	// BindAddr is the address that the service should be exposed on
+	BindAddr string
+	// Services is a list of services to enable
+	Services []string
+	// Timeout of the requests
+	Timeout time.Duration
+	// Heartbeat interval
+	Heartbeat Duration
+	MaxConns  int64
+	Ratio     float64
+}
+
+// GetBindAddr returns the BindAddr
+func (c *HTTPServer) GetBindAddr() string {
+	return c.BindAddr
+}
+
+// RepoLogLevel contains the log level per repo
+type RepoLogLevel struct {
+	Repo  string
+	Level string
+}
+
+// Broken contains fields with types that configen doesn't support
+type Broken struct {
+	Enabled bool
+	Labels  map[string]string
+	Levels  []*bool
+	Count   int32
+	Parent  *Broken
+	Started time.Time
+	*HTTPServer
+}
+
+// Listener embeds the HTTPServer
+type Listener struct {
+	HTTPServer
+	// Port to listen on
+	Port int
+}
+
+// Tagged has struct tags that set the keys of the fields in the config files
+type Tagged struct {
+	// BindAddr is the address to listen on
+	BindAddr string `json:"bind_addr,omitempty" yaml:"bind_addr" env:"LISTEN_ADDR"`
+	// Port to listen on
+	Port int `json:",omitempty" toml:"port"`
+	// Region the service runs in
+	Region string `json:"Region"`
+	// Secret isn't read from the config files
+	Secret string `json:"-"`
+}
+
+// Router has maps and pointers of the related types
+type Router struct {
+	// Routes are the routes by name
+	Routes map[string]Route
+	// Default is used when none of the Routes match
+	Default *Route
+}
+
+// Route is a node in the routing tree
+type Route struct {
+	Prefix   string
+	Children []Route
+	Variants map[string]Route
+	Fallback *Route
+}
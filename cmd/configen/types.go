@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"sort"
+	"strings"
 )
 
 // this file contains everything related to the metadata we need about
@@ -21,6 +22,10 @@ const (
 	osCompareNil
 	// generate a container based override instead
 	osStruct
+	// compare to the zero value of the type, used for external types
+	osCompareZeroValue
+	// the override is applied by a custom merge function
+	osCustom
 )
 
 // typeInfo is metadata about a go type that is usable from the config definition
@@ -35,12 +40,17 @@ type typeInfo struct {
 	ExampleValues []string
 	// if the type is a struct, a pointer to the struct definition for this type
 	structDef *structInfo
+	// if the type is an external type, a pointer to its definition
+	external *externalTypeDef
 }
 
 // OverrideExpr generates the source code of the expression that checks if
 // the override should be applied, it is go type specific
 // it assumes that the variable containing the override value is called 'o'
 func (t *typeInfo) OverrideExpr() string {
+	if t.overrideStyle == osCompareZeroValue {
+		return fmt.Sprintf(`*o != *new(%s)`, t.Name)
+	}
 	return t.overrideStyle.overrideExpr()
 }
 
@@ -58,12 +68,115 @@ func (o overrideExprType) overrideExpr() string {
 	return fmt.Sprintf(`*UNEXPECTED_OVERRIDE_STYLE_%d`, o)
 }
 
+// OverrideAssign returns the source code of the statement that applies the
+// override value 'o' to 'd', the override value is copied so that they don't share
+// any slices or pointers
+func (t *typeInfo) OverrideAssign() string {
+	if cf := t.CloneFunc(); cf != "" {
+		return fmt.Sprintf("*d = %s(*o)", cf)
+	}
+	return "*d = *o"
+}
+
+// IsSlice returns true if the type is a slice
+func (t *typeInfo) IsSlice() bool {
+	return strings.HasPrefix(t.Name, "[]")
+}
+
+// IsMap returns true if the type is a map of a related type
+func (t *typeInfo) IsMap() bool {
+	return t.external == nil && strings.HasPrefix(t.Name, "map[")
+}
+
+// IsPointer returns true if the type is a pointer, i.e. *bool or a pointer to a related type,
+// the external types are excluded, as they are copied the way the external type specifies
+func (t *typeInfo) IsPointer() bool {
+	return t.external == nil && strings.HasPrefix(t.Name, "*")
+}
+
+// isIndirect returns true if the type is a slice, map or pointer of a related type,
+// these are the types that allow a related type to contain itself
+func (t *typeInfo) isIndirect() bool {
+	return t.structDef != nil && (t.IsSlice() || t.IsMap() || t.IsPointer())
+}
+
+// relatedTypePrefixes are the prefixes of the field types that refer to a related type
+// indirectly, e.g. []Node, map[string]Node or *Node
+var relatedTypePrefixes = []string{"[]", "map[string]", "*"}
+
+// splitTypePrefix returns the slice, map or pointer prefix of the type, and the type
+// of its elements, the prefix is empty for other types
+func splitTypePrefix(typ string) (string, string) {
+	for _, p := range relatedTypePrefixes {
+		if strings.HasPrefix(typ, p) {
+			return p, typ[len(p):]
+		}
+	}
+	return "", typ
+}
+
+// CloneFunc returns the name of the function that deep copies a value of this type,
+// or an empty string if the type can be copied by value
+func (t *typeInfo) CloneFunc() string {
+	if t.external != nil {
+		return t.external.CloneFunc
+	}
+	if t.IsSlice() || t.IsMap() || t.IsPointer() {
+		return "clone" + strings.TrimPrefix(t.OverrideFunc, "override")
+	}
+	return ""
+}
+
+// CloneFuncImpl returns the source code of the generated CloneFunc for this type,
+// or an empty string if the type doesn't have a generated CloneFunc
+func (t *typeInfo) CloneFuncImpl() string {
+	cf := t.CloneFunc()
+	if cf == "" || t.external != nil {
+		return ""
+	}
+	switch {
+	case t.IsMap():
+		return fmt.Sprintf("func %s(v %s) %s {\n\tif v == nil {\n\t\treturn nil\n\t}\n\tres := make(%s, len(v))\n"+
+			"\tfor k, e := range v {\n\t\tres[k] = *e.Clone()\n\t}\n\treturn res\n}", cf, t.Name, t.Name, t.Name)
+	case t.IsPointer() && t.structDef != nil:
+		return fmt.Sprintf("func %s(v %s) %s {\n\treturn v.Clone()\n}", cf, t.Name, t.Name)
+	case !t.IsSlice():
+		return fmt.Sprintf("func %s(v %s) %s {\n\tif v == nil {\n\t\treturn nil\n\t}\n\tres := *v\n\treturn &res\n}", cf, t.Name, t.Name)
+	}
+	copyImpl := "\tcopy(res, v)"
+	if t.structDef != nil {
+		copyImpl = "\tfor i := range v {\n\t\tres[i] = *v[i].Clone()\n\t}"
+	}
+	return fmt.Sprintf("func %s(v %s) %s {\n\tif v == nil {\n\t\treturn nil\n\t}\n\tres := make(%s, len(v))\n%s\n\treturn res\n}",
+		cf, t.Name, t.Name, t.Name, copyImpl)
+}
+
+// KeysFunc returns the name of the function that returns the sorted keys of a map
+// of this type, or an empty string if the type isn't a map
+func (t *typeInfo) KeysFunc() string {
+	if !t.IsMap() {
+		return ""
+	}
+	return "keys" + strings.TrimPrefix(t.OverrideFunc, "override")
+}
+
+// KeysFuncImpl returns the source code of the generated KeysFunc for this type,
+// or an empty string if the type isn't a map
+func (t *typeInfo) KeysFuncImpl() string {
+	kf := t.KeysFunc()
+	if kf == "" {
+		return ""
+	}
+	return fmt.Sprintf("func %s(m %s) []string {\n\tres := make([]string, 0, len(m))\n\tfor k := range m {\n"+
+		"\t\tres = append(res, k)\n\t}\n\tsort.Strings(res)\n\treturn res\n}", kf, t.Name)
+}
+
 // RequiresOverrideImpl returns true if this type requires an override impl
 // that checks the override value first [i.e. the simple type overrides]
 // if this returns false, then its typically a type that delegates overrides
 // to other types [like the overrideFrom method that is generated for structs]
 func (t *typeInfo) RequiresOverrideImpl() bool {
-	return t.overrideStyle != osStruct
+	return t.overrideStyle != osStruct && t.overrideStyle != osCustom
 }
 
 type typeInfos []*typeInfo
@@ -89,20 +202,20 @@ var stdTypesByName map[string]*typeInfo
 
 func init() {
 	base := []typeInfo{
-		{"string", "overrideString", osCompareString, []string{`"one"`, `"two"`, `"three"`}, nil},
-		{"*bool", "overrideBool", osCompareNil, []string{`&trueVal`, `&falseVal`, `&trueVal`}, nil},
-		{"int", "overrideInt", osCompareZero, []string{`-42`, `42`, `1234`}, nil},
-		{"int64", "overrideInt64", osCompareZero, []string{`int64(-1234)`, `int64(123)`, `int64(19413241)`}, nil},
-		{"uint64", "overrideUint64", osCompareZero, []string{`uint64(42)`, `uint64(1)`, `uint64(1234132)`}, nil},
-		{"float64", "overrideFloat64", osCompareZero, []string{`float64(0.1)`, `float64(1.0)`, `float64(42.42)`}, nil},
-		{"Duration", "overrideDuration", osCompareZero, []string{`Duration(time.Second)`, `Duration(time.Minute)`, `Duration(time.Hour)`}, nil},
-		{"[]string", "overrideStrings", osLen, []string{`[]string{"a"}`, `[]string{"b", "b"}`, `[]string{"c", "c", "c"}`}, nil},
-		{"[]bool", "overrideBools", osLen, []string{`[]bool{true}`, `[]bool{false}`, `[]bool{false,true}`}, nil},
-		{"[]int", "overrideInts", osLen, []string{`[]int{1}`, `[]int{2, 2}`, `[]int{3, 3, 3}`}, nil},
-		{"[]int64", "overrideInt64s", osLen, []string{`[]int64{1}`, `[]int64{-1, 1}`, `[]int64{-1, 0, 1}`}, nil},
-		{"[]uint64", "overrideUint64s", osLen, []string{`[]uint64{1}`, `[]uint64{0, 1}`, `[]uint64{65}`}, nil},
-		{"[]float64", "overrideFloat64s", osLen, []string{`[]float64{0}`, `[]float64{1, 1}`, `[]float64{-1, 0, 42.42}`}, nil},
-		{"[]Duration", "overrideDurations", osLen, []string{`[]Duration{Duration(time.Second)}`, `[]Duration{Duration(time.Second), Duration(time.Minute)}`, `[]Duration{Duration(time.Hour), Duration(time.Minute *3), Duration(time.Second * 10)}`}, nil},
+		{"string", "overrideString", osCompareString, []string{`"one"`, `"two"`, `"three"`}, nil, nil},
+		{"*bool", "overrideBool", osCompareNil, []string{`&trueVal`, `&falseVal`, `&trueVal`}, nil, nil},
+		{"int", "overrideInt", osCompareZero, []string{`-42`, `42`, `1234`}, nil, nil},
+		{"int64", "overrideInt64", osCompareZero, []string{`int64(-1234)`, `int64(123)`, `int64(19413241)`}, nil, nil},
+		{"uint64", "overrideUint64", osCompareZero, []string{`uint64(42)`, `uint64(1)`, `uint64(1234132)`}, nil, nil},
+		{"float64", "overrideFloat64", osCompareZero, []string{`float64(0.1)`, `float64(1.0)`, `float64(42.42)`}, nil, nil},
+		{"Duration", "overrideDuration", osCompareZero, []string{`Duration(time.Second)`, `Duration(time.Minute)`, `Duration(time.Hour)`}, nil, nil},
+		{"[]string", "overrideStrings", osLen, []string{`[]string{"a"}`, `[]string{"b", "b"}`, `[]string{"c", "c", "c"}`}, nil, nil},
+		{"[]bool", "overrideBools", osLen, []string{`[]bool{true}`, `[]bool{false}`, `[]bool{false,true}`}, nil, nil},
+		{"[]int", "overrideInts", osLen, []string{`[]int{1}`, `[]int{2, 2}`, `[]int{3, 3, 3}`}, nil, nil},
+		{"[]int64", "overrideInt64s", osLen, []string{`[]int64{1}`, `[]int64{-1, 1}`, `[]int64{-1, 0, 1}`}, nil, nil},
+		{"[]uint64", "overrideUint64s", osLen, []string{`[]uint64{1}`, `[]uint64{0, 1}`, `[]uint64{65}`}, nil, nil},
+		{"[]float64", "overrideFloat64s", osLen, []string{`[]float64{0}`, `[]float64{1, 1}`, `[]float64{-1, 0, 42.42}`}, nil, nil},
+		{"[]Duration", "overrideDurations", osLen, []string{`[]Duration{Duration(time.Second)}`, `[]Duration{Duration(time.Second), Duration(time.Minute)}`, `[]Duration{Duration(time.Hour), Duration(time.Minute *3), Duration(time.Second * 10)}`}, nil, nil},
 	}
 	stdTypes = make([]*typeInfo, len(base))
 	stdTypesByName = make(map[string]*typeInfo, len(base))
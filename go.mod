@@ -1,6 +1,6 @@
 module github.com/go-phorce/configen
 
-go 1.14
+go 1.16
 
 require (
 	github.com/go-phorce/cov-report v1.1.1-0.20200622030546-3fb510c4b1ba
@@ -17,5 +17,5 @@ require (
 	golang.org/x/tools v0.0.0-20200619210111-0f592d2728bb
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 	gopkg.in/yaml.v2 v2.3.0 // indirect
-	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776
 )